@@ -444,6 +444,33 @@ func loadAndCompileSchema(log *logger.Logger) *gojsonschema.Schema {
 	return compiledSchema
 }
 
+// SchemaViolation describes a part of a flag configuration that doesn't conform to the flagd flag schema
+type SchemaViolation struct {
+	// Field is the path of the offending value, e.g. flags.myFlag.variants
+	Field       string
+	Description string
+}
+
+// ValidateSchema validates config against the flagd flag schema, returning every violation found. Unlike SetState,
+// which only logs them, it lets callers reject configurations that don't conform.
+func ValidateSchema(log *logger.Logger, config string) ([]SchemaViolation, error) {
+	compiledSchema := loadAndCompileSchema(log)
+
+	result, err := compiledSchema.Validate(gojsonschema.NewStringLoader(config))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute JSON schema validation: %w", err)
+	}
+
+	violations := make([]SchemaViolation, 0, len(result.Errors()))
+	for _, resultError := range result.Errors() {
+		violations = append(violations, SchemaViolation{
+			Field:       resultError.Field(),
+			Description: resultError.Description(),
+		})
+	}
+	return violations, nil
+}
+
 // configToFlagDefinition convert string configurations to flags and store them to pointer newFlags
 func configToFlagDefinition(log *logger.Logger, config string, definition *Definition) error {
 	compiledSchema := loadAndCompileSchema(log)
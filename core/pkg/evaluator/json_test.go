@@ -411,6 +411,19 @@ func TestSetState_Invalid_Error(t *testing.T) {
 	}
 }
 
+func TestValidateSchema(t *testing.T) {
+	violations, err := evaluator.ValidateSchema(logger.NewLogger(nil, false), ValidFlags)
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+
+	violations, err = evaluator.ValidateSchema(logger.NewLogger(nil, false), InvalidFlags)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, violations)
+	for _, violation := range violations {
+		assert.NotEmpty(t, violation.Description)
+	}
+}
+
 func TestSetState_Valid_NoError(t *testing.T) {
 	evaluator := evaluator.NewJSON(logger.NewLogger(nil, false), store.NewFlags())
 
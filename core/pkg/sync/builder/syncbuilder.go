@@ -132,7 +132,7 @@ func (sb *SyncBuilder) syncFromConfig(sourceConfig sync.SourceConfig, logger *lo
 		logger.Debug(fmt.Sprintf("using blob sync-provider with s3 driver for: %s", sourceConfig.URI))
 		return sb.newS3(sourceConfig, logger), nil
 	case syncProviderRedis:
-		logger.Debug(fmt.Sprintf("using redis sync-provider for: %s", sourceConfig.URI))
+		logger.Debug(fmt.Sprintf("using redis sync-provider for: %s", redis.RedactURI(sourceConfig.URI)))
 		return sb.newRedisFromConfig(sourceConfig, logger)
 
 	default:
@@ -0,0 +1,104 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// ErrCommandNotAllowed is returned when a command outside the configured allowlist is attempted
+var ErrCommandNotAllowed = errors.New("command not in the allowlist")
+
+// handshakeCommands are issued by go-redis when establishing a connection and are always allowed
+var handshakeCommands = map[string]bool{
+	"hello":          true,
+	"auth":           true,
+	"select":         true,
+	"readonly":       true,
+	"client setname": true,
+	"client setinfo": true,
+}
+
+// auditHook is a go-redis hook logging the name of every command issued and enforcing an optional allowlist
+type auditHook struct {
+	logger *logger.Logger
+	// fields identify the synced key and its source in log entries, see Sync.logFields
+	fields  []zap.Field
+	log     bool
+	allowed map[string]bool
+}
+
+// newAuditHook creates the hook. An empty allowlist allows every command.
+func newAuditHook(logger *logger.Logger, fields []zap.Field, log bool, allowed []string) auditHook {
+	hook := auditHook{logger: logger, fields: fields, log: log}
+	if len(allowed) > 0 {
+		hook.allowed = make(map[string]bool, len(allowed))
+		for _, name := range allowed {
+			hook.allowed[name] = true
+		}
+	}
+	return hook
+}
+
+func (h auditHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (h auditHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if err := h.audit(cmd); err != nil {
+			return err
+		}
+		return next(ctx, cmd)
+	}
+}
+
+func (h auditHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		for _, cmd := range cmds {
+			if err := h.audit(cmd); err != nil {
+				return err
+			}
+		}
+		return next(ctx, cmds)
+	}
+}
+
+// logFields returns the fields identifying the synced key and its source followed by fields
+func (h auditHook) logFields(fields ...zap.Field) []zap.Field {
+	return append(slices.Clip(h.fields), fields...)
+}
+
+// audit logs the command, without its arguments, and fails it if it isn't allowed
+func (h auditHook) audit(cmd redis.Cmder) error {
+	name := commandName(cmd)
+	if h.log {
+		h.logger.Info("Redis command issued", h.logFields(zap.String("command", name))...)
+	}
+
+	if h.allowed == nil || h.allowed[name] || handshakeCommands[name] {
+		return nil
+	}
+
+	err := fmt.Errorf("%w: %s", ErrCommandNotAllowed, name)
+	h.logger.Error("refused Redis command", h.logFields(zap.Error(err))...)
+	cmd.SetErr(err)
+	return err
+}
+
+// parseCommandList parses a comma separated list of command names, such as "json.get,get,config get"
+func parseCommandList(value string) []string {
+	var commands []string
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			commands = append(commands, name)
+		}
+	}
+	return commands
+}
@@ -0,0 +1,67 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestAuditMode_RefusesUnexpectedCommand(t *testing.T) {
+	ctx := context.Background()
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	// nothing listens on port 1, so commands reaching the network fail with a connection error
+	rs, err := NewRedisSync("redis://127.0.0.1:1?key=flags&allowed-commands=JSON.GET,%20get", log)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"json.get", "get"}, rs.AllowedCommands)
+
+	client := rs.Client.(goRedisClient)
+	defer client.Close()
+
+	err = client.Ping(ctx).Err()
+	assert.ErrorIs(t, err, ErrCommandNotAllowed)
+	assert.ErrorContains(t, err, "ping")
+
+	_, err = client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Get(ctx, "flags")
+		pipe.ConfigGet(ctx, notifyKeyspaceEvents)
+		return nil
+	})
+	assert.ErrorIs(t, err, ErrCommandNotAllowed)
+
+	// allowed commands are let through
+	err = client.Get(ctx, "flags").Err()
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrCommandNotAllowed)
+}
+
+func TestAuditMode_LogsCommandNames(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	fields := sourceFields("redis://localhost:6379", "flags", "")
+	hook := newAuditHook(logger.NewLogger(zap.New(core), false), fields, true, nil)
+
+	cmd := redis.NewStringCmd(context.Background(), "get", "flags-with-secret-name")
+	require.NoError(t, hook.audit(cmd))
+
+	entries := logs.All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "Redis command issued", entries[0].Message)
+	assert.Equal(t, map[string]interface{}{"key": "flags", "source": "redis://localhost:6379", "command": "get"},
+		entries[0].ContextMap())
+}
+
+func TestAuditHook_AllowsHandshake(t *testing.T) {
+	hook := newAuditHook(logger.NewLogger(zap.NewNop(), false), nil, false, []string{"json.get"})
+	ctx := context.Background()
+
+	assert.NoError(t, hook.audit(redis.NewStatusCmd(ctx, "hello", 3)))
+	assert.NoError(t, hook.audit(redis.NewStatusCmd(ctx, "select", 1)))
+	assert.NoError(t, hook.audit(redis.NewStatusCmd(ctx, "client", "setinfo", "LIB-NAME", "go-redis")))
+	assert.ErrorIs(t, hook.audit(redis.NewStatusCmd(ctx, "client", "kill", "ID", 1)), ErrCommandNotAllowed)
+}
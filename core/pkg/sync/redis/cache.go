@@ -0,0 +1,66 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+)
+
+// hasCache reports whether a cached configuration is available to start from
+func (rs *Sync) hasCache() bool {
+	if rs.CacheFile == "" {
+		return false
+	}
+	_, err := os.Stat(rs.CacheFile)
+	return err == nil
+}
+
+// writeCache stores the configuration in CacheFile. The file is replaced atomically, so that a crash never leaves
+// a truncated configuration behind.
+func (rs *Sync) writeCache(data string) error {
+	tmp, err := os.CreateTemp(filepath.Dir(rs.CacheFile), filepath.Base(rs.CacheFile)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("unable to create Redis cache file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("unable to write Redis cache file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("unable to write Redis cache file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), rs.CacheFile); err != nil {
+		return fmt.Errorf("unable to replace Redis cache file: %w", err)
+	}
+
+	return nil
+}
+
+// initialFetch fetches the configuration at startup. When Redis can't be reached, the cached configuration is
+// returned instead and polling keeps retrying Redis, emitting its configuration once a fetch succeeds.
+func (rs *Sync) initialFetch(ctx context.Context) (string, error) {
+	data, err := rs.fetchData(ctx)
+	if err == nil || rs.CacheFile == "" {
+		return data, err
+	}
+
+	cached, cacheErr := os.ReadFile(rs.CacheFile)
+	if cacheErr != nil {
+		rs.Logger.Warn("unable to read Redis cache file", rs.logFields(zap.Error(cacheErr))...)
+		return "", err
+	}
+
+	rs.Logger.Warn("initial Redis fetch failed, using the cached configuration until Redis is reachable",
+		rs.logFields(zap.String("cacheFile", rs.CacheFile), zap.Error(err))...)
+
+	rs.mu.Lock()
+	rs.disconnected = true
+	rs.mu.Unlock()
+
+	return string(cached), nil
+}
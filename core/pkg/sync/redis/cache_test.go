@@ -0,0 +1,118 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// unreachable makes the next JSON.GET and GET of key fail as if Redis was down
+func unreachable(m *MockRedisClient, key string) {
+	failed := &redis.JSONCmd{}
+	failed.SetErr(errors.New("connection refused"))
+	m.On("JSONGet", mock.Anything, key, mock.Anything).Return(failed).Once()
+	m.On("Get", mock.Anything, key).Return(redis.NewStringResult("", errors.New("connection refused"))).Once()
+}
+
+func TestRedisSync_fetchDataWritesCache(t *testing.T) {
+	flagData := `{"flags":{"test":{"state":"ENABLED"}}}`
+	cacheFile := filepath.Join(t.TempDir(), "flags.json")
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(flagData)).Once()
+	unreachable(mockClient, "test-key")
+
+	rs := &Sync{
+		Client:    mockClient,
+		Logger:    logger.NewLogger(zap.NewNop(), false),
+		Key:       "test-key",
+		CacheFile: cacheFile,
+	}
+
+	_, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+
+	cached, err := os.ReadFile(cacheFile)
+	require.NoError(t, err)
+	assert.Equal(t, flagData, string(cached))
+
+	// a failed fetch leaves the cache untouched
+	_, err = rs.fetchData(context.Background())
+	require.Error(t, err)
+
+	cached, err = os.ReadFile(cacheFile)
+	require.NoError(t, err)
+	assert.Equal(t, flagData, string(cached))
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_StartsFromCacheWhenRedisIsDown(t *testing.T) {
+	cachedData := `{"flags":{"test":{"state":"DISABLED"}}}`
+	freshData := `{"flags":{"test":{"state":"ENABLED"}}}`
+	cacheFile := filepath.Join(t.TempDir(), "flags.json")
+	require.NoError(t, os.WriteFile(cacheFile, []byte(cachedData), 0o600))
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("Ping", mock.Anything).Return(redis.NewStatusResult("", errors.New("connection refused"))).Once()
+	unreachable(mockClient, "test-key")
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(freshData)).Once()
+	mockClient.On("Close").Return(nil)
+
+	mockCron := &MockCron{}
+	mockCron.On("AddFunc", "@every 30s", mock.Anything).Return(nil)
+	mockCron.On("Start").Return()
+	mockCron.On("Stop").Return()
+
+	rs := &Sync{
+		URI:       "redis://localhost:6379/0?key=test-key",
+		Client:    mockClient,
+		Cron:      mockCron,
+		Logger:    logger.NewLogger(zap.NewNop(), false),
+		Key:       "test-key",
+		Interval:  30,
+		CacheFile: cacheFile,
+	}
+
+	require.NoError(t, rs.Init(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dataSync := make(chan sync.DataSync, 1)
+	done := make(chan error)
+	go func() {
+		done <- rs.Sync(ctx, dataSync)
+	}()
+	assert.Equal(t, cachedData, (<-dataSync).FlagData)
+
+	// polling keeps retrying Redis and replaces the cached configuration
+	mockCron.TriggerFunc(0)
+	assert.Equal(t, freshData, (<-dataSync).FlagData)
+
+	cancel()
+	require.NoError(t, <-done)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_InitFailsWithoutCache(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("Ping", mock.Anything).Return(redis.NewStatusResult("", errors.New("connection refused"))).Once()
+
+	rs := &Sync{
+		Client:    mockClient,
+		Logger:    logger.NewLogger(zap.NewNop(), false),
+		Key:       "test-key",
+		CacheFile: filepath.Join(t.TempDir(), "missing.json"),
+	}
+
+	require.Error(t, rs.Init(context.Background()))
+	mockClient.AssertExpectations(t)
+}
@@ -0,0 +1,55 @@
+package redis
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	// compressionGzip is the compression query parameter value for gzip-compressed values
+	compressionGzip = "gzip"
+	// maxDecompressedSize bounds the size of a decompressed value, guarding against decompression bombs
+	maxDecompressedSize = 64 << 20
+)
+
+// gzipMagic is the header starting every gzip stream
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// parseCompression validates the compression query parameter
+func parseCompression(value string) (string, error) {
+	switch strings.ToLower(value) {
+	case "":
+		return "", nil
+	case compressionGzip:
+		return compressionGzip, nil
+	default:
+		return "", fmt.Errorf("unsupported value for query parameter 'compression': %s", value)
+	}
+}
+
+// decompress returns the decompressed value of key when Compression is set. The gzip header is checked first, so
+// that values stored uncompressed are still read.
+func (rs *Sync) decompress(key, value string) (string, error) {
+	if rs.Compression != compressionGzip || !bytes.HasPrefix([]byte(value), gzipMagic) {
+		return value, nil
+	}
+
+	reader, err := gzip.NewReader(strings.NewReader(value))
+	if err != nil {
+		return "", fmt.Errorf("failed to decompress gzip value of Redis key %s: %w", key, err)
+	}
+	defer reader.Close()
+
+	decompressed, err := io.ReadAll(io.LimitReader(reader, maxDecompressedSize+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to decompress gzip value of Redis key %s: %w", key, err)
+	}
+	if len(decompressed) > maxDecompressedSize {
+		return "", fmt.Errorf("decompressed value of Redis key %s exceeds %d bytes", key, maxDecompressedSize)
+	}
+
+	return string(decompressed), nil
+}
@@ -0,0 +1,91 @@
+package redis
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// gzipped compresses value with gzip
+func gzipped(t *testing.T, value string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	_, err := writer.Write([]byte(value))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	return buf.String()
+}
+
+func TestNewRedisSync_Compression(t *testing.T) {
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags&compression=gzip", logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.Equal(t, compressionGzip, rs.Compression)
+
+	_, err = NewRedisSync("redis://localhost:6379/0?key=flags&compression=zstd", logger.NewLogger(zap.NewNop(), false))
+	require.Error(t, err)
+}
+
+func TestRedisSync_fetchDataDecompressesGzip(t *testing.T) {
+	flagData := `{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+
+	tests := []struct {
+		name        string
+		value       string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "gzip-compressed document",
+			value:    gzipped(t, flagData),
+			expected: flagData,
+		},
+		{
+			name:     "uncompressed document",
+			value:    flagData,
+			expected: flagData,
+		},
+		{
+			name:        "corrupted gzip stream",
+			value:       gzipped(t, flagData)[:20],
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrongType := &redis.JSONCmd{}
+			wrongType.SetErr(errors.New("WRONGTYPE Operation against a key holding the wrong kind of value"))
+
+			mockClient := &MockRedisClient{}
+			mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(wrongType)
+			mockClient.On("Get", mock.Anything, "test-key").Return(redis.NewStringResult(tt.value, nil))
+
+			rs := &Sync{
+				Client:      mockClient,
+				Logger:      logger.NewLogger(zap.NewNop(), false),
+				Key:         "test-key",
+				Compression: compressionGzip,
+			}
+
+			data, err := rs.fetchData(context.Background())
+			if tt.expectError {
+				require.ErrorContains(t, err, "failed to decompress")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, data)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package redis
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	flagsync "github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// TestRedisSync_ConcurrentAccess polls and fetches while reading the state, meant to be run with -race
+func TestRedisSync_ConcurrentAccess(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).
+		Return(jsonCmd(`{"lastModified":"2024-05-01T12:00:00Z","flags":{}}`))
+
+	rs := &Sync{
+		URI:    "redis://localhost:6379/0?key=test-key",
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "test-key",
+	}
+	dataSync := make(chan flagsync.DataSync, 100)
+
+	const iterations = 50
+	var wg sync.WaitGroup
+	wg.Add(4)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < iterations; i++ {
+			rs.poll(context.Background(), dataSync)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < iterations; i++ {
+			_, err := rs.fetchData(context.Background())
+			assert.NoError(t, err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < iterations; i++ {
+			_ = rs.IsReady()
+			_ = rs.Stats()
+			_, _ = rs.SyncLag()
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < iterations; i++ {
+			rs.SetInterval(uint32(i + 1))
+			_ = rs.configuredInterval()
+		}
+	}()
+	wg.Wait()
+
+	assert.Equal(t, uint64(2*iterations), rs.Stats().FetchCount)
+}
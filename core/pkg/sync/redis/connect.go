@@ -0,0 +1,79 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultConnectRetries is the number of times a failed connection is retried when connect_retries isn't set
+	defaultConnectRetries = 3
+	// defaultConnectBackoff is the delay before the first retry when connect_backoff isn't set
+	defaultConnectBackoff = 500 * time.Millisecond
+	// maxConnectBackoff caps the delay between retries
+	maxConnectBackoff = 30 * time.Second
+)
+
+// parseConnectRetry parses the connection retry count and base delay from the query parameters
+func parseConnectRetry(query url.Values) (int, time.Duration, error) {
+	retries := defaultConnectRetries
+	if value := query.Get("connect_retries"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			return 0, 0, fmt.Errorf("invalid value for query parameter 'connect_retries': %s", value)
+		}
+		retries = parsed
+	}
+
+	backoff, err := parseDurationParam(query, "connect_backoff")
+	if err != nil {
+		return 0, 0, err
+	}
+	if backoff == 0 {
+		backoff = defaultConnectBackoff
+	}
+
+	return retries, backoff, nil
+}
+
+// parseProtocol parses the RESP protocol version of the protocol query parameter, zero keeping the go-redis default
+func parseProtocol(value string) (int, error) {
+	switch value {
+	case "":
+		return 0, nil
+	case "2", "3":
+		return strconv.Atoi(value)
+	default:
+		return 0, fmt.Errorf("invalid value for query parameter 'protocol': %s, expected 2 or 3", value)
+	}
+}
+
+// connect pings Redis, retrying failed attempts ConnectRetries times with an exponential backoff starting at
+// ConnectBackoff
+func (rs *Sync) connect(ctx context.Context) error {
+	delay := rs.ConnectBackoff
+	for attempt := 1; ; attempt++ {
+		err := rs.client().Ping(ctx).Err()
+		if err == nil || attempt > rs.ConnectRetries {
+			return err
+		}
+
+		rs.Logger.Debug("unable to connect to Redis, retrying", rs.logFields(zap.Duration("delay", delay),
+			zap.Int("retry", attempt), zap.Int("retries", rs.ConnectRetries), zap.Error(err))...)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w, last error: %w", ctx.Err(), err)
+		case <-timer.C:
+		}
+
+		delay = min(2*delay, maxConnectBackoff)
+	}
+}
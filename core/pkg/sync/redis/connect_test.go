@@ -0,0 +1,173 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_ConnectRetry(t *testing.T) {
+	tests := []struct {
+		name            string
+		uri             string
+		expectError     bool
+		expectedRetries int
+		expectedBackoff time.Duration
+	}{
+		{
+			name:            "defaults",
+			uri:             "redis://localhost:6379/0?key=flags",
+			expectedRetries: defaultConnectRetries,
+			expectedBackoff: defaultConnectBackoff,
+		},
+		{
+			name:            "configured",
+			uri:             "redis://localhost:6379/0?key=flags&connect_retries=5&connect_backoff=2s",
+			expectedRetries: 5,
+			expectedBackoff: 2 * time.Second,
+		},
+		{
+			name:            "retries disabled",
+			uri:             "redis://localhost:6379/0?key=flags&connect_retries=0",
+			expectedRetries: 0,
+			expectedBackoff: defaultConnectBackoff,
+		},
+		{
+			name:        "negative retries",
+			uri:         "redis://localhost:6379/0?key=flags&connect_retries=-1",
+			expectError: true,
+		},
+		{
+			name:        "invalid backoff",
+			uri:         "redis://localhost:6379/0?key=flags&connect_backoff=soon",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, err := NewRedisSync(tt.uri, logger.NewLogger(zap.NewNop(), false))
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			defer rs.Close()
+
+			assert.Equal(t, tt.expectedRetries, rs.ConnectRetries)
+			assert.Equal(t, tt.expectedBackoff, rs.ConnectBackoff)
+		})
+	}
+}
+
+func TestNewRedisSync_Protocol(t *testing.T) {
+	tests := []struct {
+		name             string
+		uri              string
+		expectError      bool
+		expectedProtocol int
+		expectedOption   int
+	}{
+		{
+			name:           "library default",
+			uri:            "redis://localhost:6379/0?key=flags",
+			expectedOption: 3,
+		},
+		{
+			name:             "RESP3",
+			uri:              "redis://localhost:6379/0?key=flags&protocol=3",
+			expectedProtocol: 3,
+			expectedOption:   3,
+		},
+		{
+			name:             "RESP2",
+			uri:              "redis://localhost:6379/0?key=flags&protocol=2",
+			expectedProtocol: 2,
+			expectedOption:   2,
+		},
+		{
+			name:        "unknown version",
+			uri:         "redis://localhost:6379/0?key=flags&protocol=4",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, err := NewRedisSync(tt.uri, logger.NewLogger(zap.NewNop(), false))
+			if tt.expectError {
+				require.ErrorContains(t, err, "protocol")
+				return
+			}
+			require.NoError(t, err)
+			defer rs.Close()
+
+			assert.Equal(t, tt.expectedProtocol, rs.Protocol)
+			assert.Equal(t, tt.expectedOption, rs.Client.(goRedisClient).Options().Protocol)
+		})
+	}
+}
+
+func TestRedisSync_InitRetriesConnection(t *testing.T) {
+	failed := redis.NewStatusResult("", errors.New("connection refused"))
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("Ping", mock.Anything).Return(failed).Twice()
+	mockClient.On("Ping", mock.Anything).Return(redis.NewStatusResult("PONG", nil)).Once()
+	restrictedServer(mockClient)
+
+	rs := &Sync{
+		Client:         mockClient,
+		Logger:         logger.NewLogger(zap.NewNop(), false),
+		Key:            "test-key",
+		ConnectRetries: 3,
+		ConnectBackoff: time.Millisecond,
+	}
+
+	require.NoError(t, rs.Init(context.Background()))
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_InitGivesUpAfterRetries(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("Ping", mock.Anything).Return(redis.NewStatusResult("", errors.New("connection refused"))).Times(3)
+
+	rs := &Sync{
+		Client:         mockClient,
+		Logger:         logger.NewLogger(zap.NewNop(), false),
+		Key:            "test-key",
+		ConnectRetries: 2,
+		ConnectBackoff: time.Millisecond,
+	}
+
+	require.ErrorContains(t, rs.Init(context.Background()), "connection refused")
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_InitStopsRetryingOnCancel(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("Ping", mock.Anything).Return(redis.NewStatusResult("", errors.New("connection refused"))).Once()
+
+	rs := &Sync{
+		Client:         mockClient,
+		Logger:         logger.NewLogger(zap.NewNop(), false),
+		Key:            "test-key",
+		ConnectRetries: 3,
+		ConnectBackoff: time.Hour,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := rs.Init(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	mockClient.AssertExpectations(t)
+}
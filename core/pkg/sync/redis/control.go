@@ -0,0 +1,86 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// controlDocument is the content of the control key, adjusting polling at runtime
+type controlDocument struct {
+	Interval *uint32 `json:"interval"`
+	Paused   bool    `json:"paused"`
+}
+
+// rescheduler is implemented by Cron implementations able to change the schedule of registered functions
+type rescheduler interface {
+	Reschedule(spec string) error
+}
+
+// applyControl reads the control key, applying its polling interval, and reports whether polling is paused.
+// A missing control document restores the configured interval, an invalid one is ignored.
+func (rs *Sync) applyControl(ctx context.Context) bool {
+	if rs.ControlKey == "" {
+		return false
+	}
+
+	data, err := rs.fetchDocument(ctx, rs.ControlKey, rootPath)
+	if err != nil {
+		rs.Logger.Warn("unable to read Redis control key, ignoring it",
+			rs.logFields(zap.String("controlKey", rs.ControlKey), zap.Error(err))...)
+		return false
+	}
+
+	var control controlDocument
+	if data != "" {
+		if err := json.Unmarshal([]byte(data), &control); err != nil {
+			rs.Logger.Warn("invalid document in Redis control key, ignoring it",
+				rs.logFields(zap.String("controlKey", rs.ControlKey), zap.Error(err))...)
+			return false
+		}
+		if control.Interval != nil && *control.Interval == 0 {
+			rs.Logger.Warn("invalid interval 0 in Redis control key, ignoring it",
+				rs.logFields(zap.String("controlKey", rs.ControlKey))...)
+			return false
+		}
+	}
+
+	interval := uint32(0)
+	if control.Interval != nil {
+		interval = *control.Interval
+	}
+	rs.applyControlInterval(interval)
+
+	return control.Paused
+}
+
+// applyControlInterval reschedules polling when the interval set by the control key changed, zero restoring the
+// configured interval
+func (rs *Sync) applyControlInterval(interval uint32) {
+	if interval == rs.controlInterval {
+		return
+	}
+
+	effective := interval
+	if effective == 0 {
+		effective = rs.configuredInterval()
+	}
+
+	cron, ok := rs.Cron.(rescheduler)
+	if !ok {
+		rs.Logger.Warn("the polling schedule doesn't support changing the interval from the control key")
+		return
+	}
+	if err := cron.Reschedule(fmt.Sprintf("@every %ds", effective)); err != nil {
+		rs.Logger.Warn("unable to apply interval from Redis control key",
+			rs.logFields(zap.String("controlKey", rs.ControlKey), zap.Error(err))...)
+		return
+	}
+
+	rs.Logger.Info("polling Redis key", rs.logFields(zap.Uint32("interval", effective))...)
+	rs.mu.Lock()
+	rs.controlInterval = interval
+	rs.mu.Unlock()
+}
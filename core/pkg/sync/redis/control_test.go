@@ -0,0 +1,100 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func missingKey(m *MockRedisClient, key string) {
+	missingJSON := &redis.JSONCmd{}
+	missingJSON.SetErr(redis.Nil)
+	m.On("JSONGet", mock.Anything, key, mock.Anything).Return(missingJSON).Once()
+	m.On("Get", mock.Anything, key).Return(redis.NewStringResult("", redis.Nil)).Once()
+}
+
+func TestRedisSync_ControlKeyChangesInterval(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "control", mock.Anything).Return(jsonCmd(`{"interval":5}`)).Once()
+	mockClient.On("JSONGet", mock.Anything, "control", mock.Anything).Return(jsonCmd(`{"interval":"fast"}`)).Once()
+	missingKey(mockClient, "control")
+
+	cron := newTickerCron()
+	require.NoError(t, cron.AddFunc("@every 30s", func() {}))
+
+	rs := &Sync{
+		Client:     mockClient,
+		Cron:       cron,
+		Logger:     logger.NewLogger(zap.NewNop(), false),
+		Key:        "flags",
+		Interval:   30,
+		ControlKey: "control",
+	}
+
+	assert.False(t, rs.applyControl(context.Background()))
+	assert.Equal(t, 5*time.Second, cron.interval)
+
+	// an invalid control document is ignored
+	assert.False(t, rs.applyControl(context.Background()))
+	assert.Equal(t, 5*time.Second, cron.interval)
+
+	// removing the control document restores the configured interval
+	assert.False(t, rs.applyControl(context.Background()))
+	assert.Equal(t, 30*time.Second, cron.interval)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_ControlKeyPausesPolling(t *testing.T) {
+	initial := `{"flags":{"test":{"state":"ENABLED"}}}`
+	updated := `{"flags":{"test":{"state":"DISABLED"}}}`
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).Return(jsonCmd(initial)).Once()
+	mockClient.On("JSONGet", mock.Anything, "control", mock.Anything).Return(jsonCmd(`{"paused":true}`)).Once()
+	mockClient.On("JSONGet", mock.Anything, "control", mock.Anything).Return(jsonCmd(`{"paused":false}`)).Once()
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).Return(jsonCmd(updated)).Once()
+	mockClient.On("Close").Return(nil)
+
+	mockCron := &MockCron{}
+	mockCron.On("AddFunc", "@every 30s", mock.Anything).Return(nil)
+	mockCron.On("Start").Return()
+	mockCron.On("Stop").Return()
+
+	rs := &Sync{
+		URI:        "redis://localhost:6379/0?key=flags&control-key=control",
+		Client:     mockClient,
+		Cron:       mockCron,
+		Logger:     logger.NewLogger(zap.NewNop(), false),
+		Key:        "flags",
+		Interval:   30,
+		ControlKey: "control",
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dataSync := make(chan sync.DataSync, 1)
+	done := make(chan error)
+	go func() {
+		done <- rs.Sync(ctx, dataSync)
+	}()
+	assert.Equal(t, initial, (<-dataSync).FlagData)
+
+	// paused, the flags key isn't fetched
+	mockCron.TriggerFunc(0)
+	assert.Empty(t, dataSync)
+
+	mockCron.TriggerFunc(0)
+	assert.Equal(t, updated, (<-dataSync).FlagData)
+
+	cancel()
+	require.NoError(t, <-done)
+	mockClient.AssertExpectations(t)
+}
@@ -0,0 +1,122 @@
+package redis
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const everyPrefix = "@every "
+
+// tickerCron implements the Cron interface on top of a time.Ticker. It accepts "@every <duration>" specs, which
+// allows polling intervals to be expressed in seconds rather than the minute granularity of a cron spec.
+type tickerCron struct {
+	mu       sync.Mutex
+	interval time.Duration
+	funcs    []func()
+	ticker   *time.Ticker
+	done     chan struct{}
+}
+
+func newTickerCron() *tickerCron {
+	return &tickerCron{}
+}
+
+// AddFunc registers cmd to run on the schedule described by spec
+func (c *tickerCron) AddFunc(spec string, cmd func()) error {
+	interval, err := parseEverySpec(spec)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.interval != 0 && c.interval != interval {
+		return fmt.Errorf("conflicting schedule %q, already running every %s", spec, c.interval)
+	}
+	c.interval = interval
+	c.funcs = append(c.funcs, cmd)
+	return nil
+}
+
+// Reschedule changes the schedule of the registered functions, taking effect from the next tick
+func (c *tickerCron) Reschedule(spec string) error {
+	interval, err := parseEverySpec(spec)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.interval = interval
+	if c.ticker != nil {
+		c.ticker.Reset(interval)
+	}
+	return nil
+}
+
+// Start begins invoking the registered functions on every tick
+func (c *tickerCron) Start() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ticker != nil || c.interval == 0 {
+		return
+	}
+
+	c.ticker = time.NewTicker(c.interval)
+	c.done = make(chan struct{})
+
+	go c.run(c.ticker, c.done)
+}
+
+// Stop halts the ticker. Functions already running are not interrupted.
+func (c *tickerCron) Stop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ticker == nil {
+		return
+	}
+
+	c.ticker.Stop()
+	close(c.done)
+	c.ticker = nil
+}
+
+func (c *tickerCron) run(ticker *time.Ticker, done <-chan struct{}) {
+	for {
+		select {
+		case <-ticker.C:
+			c.mu.Lock()
+			funcs := append([]func(){}, c.funcs...)
+			c.mu.Unlock()
+
+			for _, f := range funcs {
+				f()
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// parseEverySpec extracts the duration from an "@every <duration>" spec
+func parseEverySpec(spec string) (time.Duration, error) {
+	if !strings.HasPrefix(spec, everyPrefix) {
+		return 0, fmt.Errorf("unsupported schedule %q, expected %s<duration>", spec, everyPrefix)
+	}
+
+	interval, err := time.ParseDuration(strings.TrimPrefix(spec, everyPrefix))
+	if err != nil {
+		return 0, fmt.Errorf("invalid schedule %q: %w", spec, err)
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("invalid schedule %q: interval must be positive", spec)
+	}
+
+	return interval, nil
+}
@@ -0,0 +1,90 @@
+package redis
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTickerCron_FiresAtInterval(t *testing.T) {
+	c := newTickerCron()
+
+	var mu sync.Mutex
+	var ticks []time.Time
+	require.NoError(t, c.AddFunc("@every 2s", func() {
+		mu.Lock()
+		defer mu.Unlock()
+		ticks = append(ticks, time.Now())
+	}))
+
+	start := time.Now()
+	c.Start()
+	defer c.Stop()
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(ticks) >= 2
+	}, 5*time.Second, 50*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.InDelta(t, 2*time.Second, ticks[0].Sub(start), float64(500*time.Millisecond))
+	assert.InDelta(t, 2*time.Second, ticks[1].Sub(ticks[0]), float64(500*time.Millisecond))
+}
+
+func TestTickerCron_Stop(t *testing.T) {
+	c := newTickerCron()
+
+	var mu sync.Mutex
+	count := 0
+	require.NoError(t, c.AddFunc("@every 50ms", func() {
+		mu.Lock()
+		defer mu.Unlock()
+		count++
+	}))
+
+	c.Start()
+	time.Sleep(120 * time.Millisecond)
+	c.Stop()
+
+	mu.Lock()
+	stopped := count
+	mu.Unlock()
+
+	time.Sleep(150 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, stopped, count)
+}
+
+func TestParseEverySpec(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        string
+		expected    time.Duration
+		expectError bool
+	}{
+		{name: "seconds", spec: "@every 30s", expected: 30 * time.Second},
+		{name: "above a minute", spec: "@every 90s", expected: 90 * time.Second},
+		{name: "minute based cron spec", spec: "*/30 * * * *", expectError: true},
+		{name: "malformed duration", spec: "@every soon", expectError: true},
+		{name: "zero duration", spec: "@every 0s", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interval, err := parseEverySpec(tt.spec)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, interval)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/sync"
+)
+
+// emitChange emits a created or changed configuration. Within MinEmitInterval of the last emit, changes are
+// coalesced instead, the latest of them being emitted once the interval has elapsed.
+func (rs *Sync) emitChange(ctx context.Context, dataSync chan<- sync.DataSync, data string) {
+	if rs.MinEmitInterval <= 0 {
+		rs.emit(ctx, dataSync, data)
+		return
+	}
+
+	rs.emitMu.Lock()
+	wait := rs.MinEmitInterval - time.Since(rs.lastEmit)
+	if wait <= 0 && rs.pendingEmit == nil {
+		rs.emitMu.Unlock()
+		rs.emit(ctx, dataSync, data)
+		return
+	}
+
+	rs.pendingData = data
+	if rs.pendingEmit == nil {
+		rs.pendingEmit = time.AfterFunc(wait, func() {
+			rs.emitPending(ctx, dataSync)
+		})
+	}
+	rs.emitMu.Unlock()
+
+	rs.Logger.Debug("coalescing configuration change within the minimum emit interval", rs.logFields()...)
+}
+
+// emitPending emits the latest coalesced configuration, unless a later emit superseded it. Like a poll it holds
+// pollMu, so that it doesn't emit once syncing stopped.
+func (rs *Sync) emitPending(ctx context.Context, dataSync chan<- sync.DataSync) {
+	rs.pollMu.Lock()
+	defer rs.pollMu.Unlock()
+
+	rs.emitMu.Lock()
+	data := rs.pendingData
+	rs.pendingData = ""
+	rs.pendingEmit = nil
+	rs.emitMu.Unlock()
+
+	if data != "" {
+		rs.emit(ctx, dataSync, data)
+	}
+}
+
+// recordEmit starts a new minimum emit interval, dropping the coalesced configuration the emit superseded
+func (rs *Sync) recordEmit() {
+	rs.emitMu.Lock()
+	defer rs.emitMu.Unlock()
+
+	rs.lastEmit = time.Now()
+	rs.pendingData = ""
+}
@@ -0,0 +1,61 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_MinEmitInterval(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags&min_emit_interval=2s", log)
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.Equal(t, 2*time.Second, rs.MinEmitInterval)
+
+	_, err = NewRedisSync("redis://localhost:6379/0?key=flags&min_emit_interval=often", log)
+	require.ErrorContains(t, err, "min_emit_interval")
+}
+
+func TestRedisSync_MinEmitIntervalCoalescesChanges(t *testing.T) {
+	configuration := func(state int) string {
+		return fmt.Sprintf(`{"flags":{"test":{"state":"ENABLED","variants":{"on":%d},"defaultVariant":"on"}}}`, state)
+	}
+
+	mockClient := &MockRedisClient{}
+	for state := 0; state < 4; state++ {
+		mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(configuration(state))).Once()
+	}
+
+	rs := &Sync{
+		URI:             "redis://localhost:6379/0?key=test-key",
+		Client:          mockClient,
+		Logger:          logger.NewLogger(zap.NewNop(), false),
+		Key:             "test-key",
+		MinEmitInterval: 200 * time.Millisecond,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dataSync := make(chan sync.DataSync, 4)
+
+	// the first change is emitted right away, the rapid changes after it within the interval are coalesced
+	rs.poll(ctx, dataSync)
+	assert.Equal(t, configuration(0), receive(t, dataSync))
+	for state := 1; state < 4; state++ {
+		rs.poll(ctx, dataSync)
+	}
+	assert.Empty(t, dataSync)
+
+	assert.Equal(t, configuration(3), receive(t, dataSync))
+	require.Never(t, func() bool { return len(dataSync) > 0 }, 300*time.Millisecond, 10*time.Millisecond)
+	mockClient.AssertExpectations(t)
+}
@@ -0,0 +1,55 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"go.uber.org/zap"
+)
+
+const (
+	// deletePolicyKeep keeps the last configuration in effect when the key is deleted, the default
+	deletePolicyKeep = "keep"
+	// deletePolicyClear emits an empty configuration when the key is deleted
+	deletePolicyClear = "clear"
+	// emptyConfiguration is the configuration emitted for a deleted key with deletePolicyClear
+	emptyConfiguration = `{"flags":{}}`
+)
+
+// parseDeletePolicy validates the delete_policy query parameter, defaulting to keep. Clearing the configuration as
+// soon as the key is missing would defeat warn_on_missing_after, so the two can't be combined.
+func parseDeletePolicy(query url.Values, warnOnMissingAfter time.Duration) (string, error) {
+	switch value := query.Get("delete_policy"); strings.ToLower(value) {
+	case "", deletePolicyKeep:
+		return deletePolicyKeep, nil
+	case deletePolicyClear:
+		if warnOnMissingAfter > 0 {
+			return "", errors.New("query parameter 'delete_policy=clear' can't be combined with 'warn_on_missing_after'")
+		}
+		return deletePolicyClear, nil
+	default:
+		return "", fmt.Errorf("unsupported value for query parameter 'delete_policy': %s, expected clear or keep", value)
+	}
+}
+
+// clearDeleted emits an empty configuration when the key, which had data as hashed by previousSHA, was deleted and
+// DeletePolicy is clear. LastSHA is reset, so that the key is emitted as created once it is written again.
+func (rs *Sync) clearDeleted(ctx context.Context, dataSync chan<- sync.DataSync, previousSHA string) bool {
+	if rs.DeletePolicy != deletePolicyClear || previousSHA == "" {
+		return false
+	}
+
+	rs.mu.Lock()
+	rs.LastSHA = ""
+	rs.mu.Unlock()
+
+	rs.Logger.Info("Redis key deleted, clearing the configuration", rs.logFields(zap.String("sha", previousSHA))...)
+	rs.metrics.configUpdated()
+	rs.emitChange(ctx, dataSync, emptyConfiguration)
+	return true
+}
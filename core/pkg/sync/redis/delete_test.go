@@ -0,0 +1,87 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_DeletePolicy(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags", log)
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.Equal(t, deletePolicyKeep, rs.DeletePolicy)
+
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags&delete_policy=clear", log)
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.Equal(t, deletePolicyClear, rs.DeletePolicy)
+
+	_, err = NewRedisSync("redis://localhost:6379/0?key=flags&delete_policy=drop", log)
+	require.ErrorContains(t, err, "delete_policy")
+
+	_, err = NewRedisSync("redis://localhost:6379/0?key=flags&delete_policy=clear&warn_on_missing_after=1m", log)
+	require.ErrorContains(t, err, "warn_on_missing_after")
+}
+
+func TestRedisSync_PollWithDeletedKey(t *testing.T) {
+	flagData := `{"flags":{"test":{"state":"ENABLED"}}}`
+
+	tests := []struct {
+		name     string
+		policy   string
+		expected []string
+	}{
+		{
+			name:     "keep",
+			policy:   deletePolicyKeep,
+			expected: []string{flagData},
+		},
+		{
+			name:   "clear",
+			policy: deletePolicyClear,
+			// the key written again after its deletion is emitted as created
+			expected: []string{flagData, emptyConfiguration, flagData},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockRedisClient{}
+			mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(flagData)).Once()
+			missingKey(mockClient, "test-key")
+			missingKey(mockClient, "test-key")
+			mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(flagData)).Once()
+
+			rs := &Sync{
+				URI:          "redis://localhost:6379/0?key=test-key",
+				Client:       mockClient,
+				Logger:       logger.NewLogger(zap.NewNop(), false),
+				Key:          "test-key",
+				DeletePolicy: tt.policy,
+			}
+			dataSync := make(chan sync.DataSync, 4)
+
+			rs.poll(context.Background(), dataSync) // initial configuration
+			rs.poll(context.Background(), dataSync) // key deleted
+			rs.poll(context.Background(), dataSync) // still deleted, cleared once
+			rs.poll(context.Background(), dataSync) // key written again
+			close(dataSync)
+
+			var emitted []string
+			for data := range dataSync {
+				emitted = append(emitted, data.FlagData)
+			}
+			assert.Equal(t, tt.expected, emitted)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
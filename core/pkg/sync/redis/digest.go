@@ -0,0 +1,67 @@
+package redis
+
+import (
+	"crypto/sha1" //nolint:gosec // only used for change detection
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// hashSHA3256 is the default algorithm of the change detection hash
+	hashSHA3256 = "sha3-256"
+	hashSHA256  = "sha256"
+	hashSHA1    = "sha1"
+
+	// encodingBase64 is the default encoding of the change detection hash, URL-safe base64
+	encodingBase64 = "base64url"
+	encodingHex    = "hex"
+)
+
+// parseHashAlgorithm validates the hash query parameter, defaulting to SHA3-256
+func parseHashAlgorithm(value string) (string, error) {
+	switch strings.ToLower(value) {
+	case "", hashSHA3256:
+		return hashSHA3256, nil
+	case hashSHA256, hashSHA1:
+		return strings.ToLower(value), nil
+	default:
+		return "", fmt.Errorf("unsupported value for query parameter 'hash': %s", value)
+	}
+}
+
+// parseHashEncoding validates the hash_encoding query parameter, defaulting to URL-safe base64
+func parseHashEncoding(value string) (string, error) {
+	switch strings.ToLower(value) {
+	case "", encodingBase64:
+		return encodingBase64, nil
+	case encodingHex:
+		return encodingHex, nil
+	default:
+		return "", fmt.Errorf("unsupported value for query parameter 'hash_encoding': %s", value)
+	}
+}
+
+// generateSHA generates the hash of data used for change detection, with HashAlgorithm and HashEncoding
+func (rs *Sync) generateSHA(data []byte) string {
+	var hasher hash.Hash
+	switch rs.HashAlgorithm {
+	case hashSHA256:
+		hasher = sha256.New()
+	case hashSHA1:
+		hasher = sha1.New() //nolint:gosec // only used for change detection
+	default:
+		hasher = sha3.New256()
+	}
+	hasher.Write(data)
+
+	if rs.HashEncoding == encodingHex {
+		return hex.EncodeToString(hasher.Sum(nil))
+	}
+	return base64.URLEncoding.EncodeToString(hasher.Sum(nil))
+}
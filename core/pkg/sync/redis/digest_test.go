@@ -0,0 +1,97 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_Hash(t *testing.T) {
+	tests := []struct {
+		name              string
+		uri               string
+		expectError       bool
+		expectedAlgorithm string
+		expectedEncoding  string
+	}{
+		{
+			name:              "defaults",
+			uri:               "redis://localhost:6379/0?key=flags",
+			expectedAlgorithm: hashSHA3256,
+			expectedEncoding:  encodingBase64,
+		},
+		{
+			name:              "sha256 hex",
+			uri:               "redis://localhost:6379/0?key=flags&hash=SHA256&hash_encoding=hex",
+			expectedAlgorithm: hashSHA256,
+			expectedEncoding:  encodingHex,
+		},
+		{
+			name:              "sha1",
+			uri:               "redis://localhost:6379/0?key=flags&hash=sha1",
+			expectedAlgorithm: hashSHA1,
+			expectedEncoding:  encodingBase64,
+		},
+		{
+			name:        "unsupported algorithm",
+			uri:         "redis://localhost:6379/0?key=flags&hash=md5",
+			expectError: true,
+		},
+		{
+			name:        "unsupported encoding",
+			uri:         "redis://localhost:6379/0?key=flags&hash_encoding=base32",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, err := NewRedisSync(tt.uri, logger.NewLogger(zap.NewNop(), false))
+			if tt.expectError {
+				require.ErrorContains(t, err, "hash")
+				return
+			}
+			require.NoError(t, err)
+			defer rs.Close()
+
+			assert.Equal(t, tt.expectedAlgorithm, rs.HashAlgorithm)
+			assert.Equal(t, tt.expectedEncoding, rs.HashEncoding)
+		})
+	}
+}
+
+func TestRedisSync_generateSHA(t *testing.T) {
+	data := []byte(`{"flags":{}}`)
+
+	tests := []struct {
+		algorithm string
+		encoding  string
+		expected  string
+	}{
+		{hashSHA3256, encodingBase64, "C3lY9-OpScNt6VVp5WkEI0jj52DIBzysqr9wvujhYBc="},
+		{hashSHA3256, encodingHex, "0b7958f7e3a949c36de95569e569042348e3e760c8073cacaabf70bee8e16017"},
+		{hashSHA256, encodingBase64, "sMdBH6oT3YduxdwO4NN0YbA0avzWNjL40mGfVfT23Eg="},
+		{hashSHA256, encodingHex, "b0c7411faa13dd876ec5dc0ee0d37461b0346afcd63632f8d2619f55f4f6dc48"},
+		{hashSHA1, encodingBase64, "ymf5smthnnoplr_TbXgsHlof0D0="},
+		{hashSHA1, encodingHex, "ca67f9b26b619e7a2996bfd36d782c1e5a1fd03d"},
+	}
+
+	seen := map[string]bool{}
+	for _, tt := range tests {
+		t.Run(tt.algorithm+" "+tt.encoding, func(t *testing.T) {
+			rs := &Sync{HashAlgorithm: tt.algorithm, HashEncoding: tt.encoding}
+
+			sha := rs.generateSHA(data)
+			assert.Equal(t, tt.expected, sha)
+			assert.Equal(t, sha, rs.generateSHA(data), "hash must be stable")
+			assert.False(t, seen[sha], "hash must be distinct")
+			seen[sha] = true
+		})
+	}
+
+	// the zero value keeps the historical SHA3-256 in URL-safe base64
+	assert.Equal(t, tests[0].expected, (&Sync{}).generateSHA(data))
+}
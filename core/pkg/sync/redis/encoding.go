@@ -0,0 +1,35 @@
+package redis
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// valueEncodingBase64 is the encoding query parameter value for base64-encoded values
+const valueEncodingBase64 = "base64"
+
+// parseEncoding validates the encoding query parameter
+func parseEncoding(value string) (string, error) {
+	switch strings.ToLower(value) {
+	case "":
+		return "", nil
+	case valueEncodingBase64:
+		return valueEncodingBase64, nil
+	default:
+		return "", fmt.Errorf("unsupported value for query parameter 'encoding': %s", value)
+	}
+}
+
+// decodeValue returns the decoded value of key when Encoding is set, before it is decompressed
+func (rs *Sync) decodeValue(key, value string) (string, error) {
+	if rs.Encoding != valueEncodingBase64 || value == "" {
+		return value, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+	if err != nil {
+		return "", fmt.Errorf("invalid base64 value of Redis key %s: %w", key, err)
+	}
+	return string(decoded), nil
+}
@@ -0,0 +1,92 @@
+package redis
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_Encoding(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags&encoding=base64", log)
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.Equal(t, valueEncodingBase64, rs.Encoding)
+
+	_, err = NewRedisSync("redis://localhost:6379/0?key=flags&encoding=hex", log)
+	require.ErrorContains(t, err, "encoding")
+}
+
+func TestRedisSync_fetchDataDecodesBase64(t *testing.T) {
+	flagData := `{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+	yamlData := "flags:\n  test:\n    state: ENABLED\n"
+
+	tests := []struct {
+		name        string
+		value       string
+		compression string
+		format      string
+		expected    string
+		expectError string
+	}{
+		{
+			name:     "base64-encoded document",
+			value:    base64.StdEncoding.EncodeToString([]byte(flagData)),
+			expected: flagData,
+		},
+		{
+			name:        "base64-encoded gzip-compressed document",
+			value:       base64.StdEncoding.EncodeToString([]byte(gzipped(t, flagData))),
+			compression: compressionGzip,
+			expected:    flagData,
+		},
+		{
+			name:     "base64-encoded YAML document",
+			value:    base64.StdEncoding.EncodeToString([]byte(yamlData)),
+			format:   formatYAML,
+			expected: `{"flags":{"test":{"state":"ENABLED"}}}`,
+		},
+		{
+			name:        "invalid base64",
+			value:       flagData,
+			expectError: "invalid base64 value of Redis key test-key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrongType := &redis.JSONCmd{}
+			wrongType.SetErr(errors.New("WRONGTYPE Operation against a key holding the wrong kind of value"))
+
+			mockClient := &MockRedisClient{}
+			mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(wrongType)
+			mockClient.On("Get", mock.Anything, "test-key").Return(redis.NewStringResult(tt.value, nil))
+
+			rs := &Sync{
+				Client:      mockClient,
+				Logger:      logger.NewLogger(zap.NewNop(), false),
+				Key:         "test-key",
+				Encoding:    valueEncodingBase64,
+				Compression: tt.compression,
+				Format:      tt.format,
+			}
+
+			data, err := rs.fetchData(context.Background())
+			if tt.expectError != "" {
+				require.ErrorContains(t, err, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, data)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package redis
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+)
+
+const (
+	// expandEnvMissingKeep leaves references to undefined environment variables as is, the default
+	expandEnvMissingKeep = "keep"
+	// expandEnvMissingError fails the fetch when a referenced environment variable is undefined
+	expandEnvMissingError = "error"
+)
+
+// parseExpandEnv parses the expand_env query parameter and what expand_env_missing does with undefined variables,
+// keep or error
+func parseExpandEnv(query url.Values) (bool, string, error) {
+	expand, err := parseBoolParam(query, "expand_env")
+	if err != nil {
+		return false, "", err
+	}
+
+	switch value := query.Get("expand_env_missing"); strings.ToLower(value) {
+	case "":
+		return expand, expandEnvMissingKeep, nil
+	case expandEnvMissingKeep, expandEnvMissingError:
+		if !expand {
+			return false, "", errors.New("query parameter 'expand_env_missing' requires 'expand_env'")
+		}
+		return expand, strings.ToLower(value), nil
+	default:
+		return false, "", fmt.Errorf(
+			"unsupported value for query parameter 'expand_env_missing': %s, expected keep or error", value)
+	}
+}
+
+// expandValueEnv replaces the ${VAR} references of the raw value of key with the process environment when ExpandEnv
+// is set, before the value is converted to JSON. Bare $VAR references aren't expanded, leaving fields such as
+// $evaluators alone. References to undefined variables are kept as is, or fail with ExpandEnvMissing set to error.
+func (rs *Sync) expandValueEnv(key, value string) (string, error) {
+	if !rs.ExpandEnv || value == "" {
+		return value, nil
+	}
+
+	var missing []string
+	expanded := envReference.ReplaceAllStringFunc(value, func(reference string) string {
+		name := envReference.FindStringSubmatch(reference)[1]
+		if env, ok := os.LookupEnv(name); ok {
+			return env
+		}
+		missing = append(missing, name)
+		return reference
+	})
+	if len(missing) > 0 && rs.ExpandEnvMissing == expandEnvMissingError {
+		return "", fmt.Errorf("undefined environment variables %s referenced by Redis key %s",
+			strings.Join(missing, ", "), key)
+	}
+	return expanded, nil
+}
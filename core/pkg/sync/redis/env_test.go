@@ -0,0 +1,114 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_ExpandEnv(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags", log)
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.False(t, rs.ExpandEnv)
+	assert.Equal(t, expandEnvMissingKeep, rs.ExpandEnvMissing)
+
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags&expand_env=true&expand_env_missing=error", log)
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.True(t, rs.ExpandEnv)
+	assert.Equal(t, expandEnvMissingError, rs.ExpandEnvMissing)
+
+	_, err = NewRedisSync("redis://localhost:6379/0?key=flags&expand_env=true&expand_env_missing=empty", log)
+	require.ErrorContains(t, err, "expand_env_missing")
+
+	_, err = NewRedisSync("redis://localhost:6379/0?key=flags&expand_env_missing=error", log)
+	require.ErrorContains(t, err, "requires 'expand_env'")
+}
+
+func TestRedisSync_fetchDataExpandsEnv(t *testing.T) {
+	t.Setenv("FLAGD_TEST_API_URL", "https://api.staging.example.com")
+
+	document := `{"$evaluators":{"internal":{"in":["@example.com",{"var":"email"}]}},"flags":{"api":{` +
+		`"state":"ENABLED","variants":{"default":"${FLAGD_TEST_API_URL}","other":"${FLAGD_TEST_UNDEFINED}"},` +
+		`"defaultVariant":"default"}}}`
+
+	tests := []struct {
+		name      string
+		expand    bool
+		missing   string
+		expected  string
+		expectErr string
+	}{
+		{
+			name:     "disabled",
+			missing:  expandEnvMissingKeep,
+			expected: document,
+		},
+		{
+			name:    "undefined variables kept",
+			expand:  true,
+			missing: expandEnvMissingKeep,
+			expected: `{"$evaluators":{"internal":{"in":["@example.com",{"var":"email"}]}},"flags":{"api":{` +
+				`"state":"ENABLED","variants":{"default":"https://api.staging.example.com",` +
+				`"other":"${FLAGD_TEST_UNDEFINED}"},"defaultVariant":"default"}}}`,
+		},
+		{
+			name:      "undefined variables fail",
+			expand:    true,
+			missing:   expandEnvMissingError,
+			expectErr: "undefined environment variables FLAGD_TEST_UNDEFINED referenced by Redis key test-key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockRedisClient{}
+			mockClient.On("Get", mock.Anything, "test-key").Return(redis.NewStringResult(document, nil))
+
+			rs := &Sync{
+				Client:           mockClient,
+				Logger:           logger.NewLogger(zap.NewNop(), false),
+				Key:              "test-key",
+				SkipJSONModule:   true,
+				ExpandEnv:        tt.expand,
+				ExpandEnvMissing: tt.missing,
+			}
+
+			data, err := rs.fetchData(context.Background())
+			if tt.expectErr != "" {
+				require.ErrorContains(t, err, tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, data)
+		})
+	}
+}
+
+func TestRedisSync_fetchDataExpandsEnvOfJSONDocuments(t *testing.T) {
+	t.Setenv("FLAGD_TEST_STATE", "DISABLED")
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(
+		`{"flags":{"test":{"state":"${FLAGD_TEST_STATE}","variants":{"on":true},"defaultVariant":"on"}}}`))
+
+	rs := &Sync{
+		Client:    mockClient,
+		Logger:    logger.NewLogger(zap.NewNop(), false),
+		Key:       "test-key",
+		ExpandEnv: true,
+	}
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"flags":{"test":{"state":"DISABLED","variants":{"on":true},"defaultVariant":"on"}}}`, data)
+}
@@ -0,0 +1,28 @@
+package redis
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// SyncError describes a failed fetch of the polling loop
+type SyncError struct {
+	// Source is the name of the provider, see SourceName, telling the providers sharing a channel apart
+	Source string
+	Time   time.Time
+	Err    error
+}
+
+// notifyError sends the failed fetch to Errors without blocking the polling loop
+func (rs *Sync) notifyError(err error) {
+	if rs.Errors == nil {
+		return
+	}
+
+	select {
+	case rs.Errors <- SyncError{Source: rs.SourceName(), Time: time.Now(), Err: err}:
+	default:
+		rs.Logger.Debug("dropped Redis sync error event, the channel is full", rs.logFields(zap.Error(err))...)
+	}
+}
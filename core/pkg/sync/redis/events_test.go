@@ -0,0 +1,51 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRedisSync_PollDeliversErrorEvents(t *testing.T) {
+	flagData := `{"flags":{"test":{"state":"ENABLED"}}}`
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(flagData)).Once()
+	unreachable(mockClient, "test-key")
+	unreachable(mockClient, "test-key")
+
+	syncErrors := make(chan SyncError, 1)
+	rs := &Sync{
+		URI:    "redis://localhost:6379/0?key=test-key",
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "test-key",
+		Errors: syncErrors,
+	}
+	dataSync := make(chan sync.DataSync, 1)
+
+	before := time.Now()
+	rs.poll(context.Background(), dataSync)
+	assert.Empty(t, syncErrors)
+
+	rs.poll(context.Background(), dataSync)
+	require.Len(t, syncErrors, 1)
+	event := <-syncErrors
+	assert.ErrorContains(t, event.Err, "connection refused")
+	assert.False(t, event.Time.Before(before))
+	assert.Equal(t, "redis://localhost:6379/0?key=test-key", event.Source)
+
+	// a full channel doesn't block polling
+	syncErrors <- SyncError{Err: errors.New("unread")}
+	rs.poll(context.Background(), dataSync)
+	assert.Len(t, syncErrors, 1)
+	mockClient.AssertExpectations(t)
+}
@@ -0,0 +1,75 @@
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// parseFlagList parses a comma separated list of flag keys, such as the flags query parameter
+func parseFlagList(value string) []string {
+	var keys []string
+	for _, key := range strings.Split(value, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// filterFlags filters the flags of the configuration data down to the ones listed in Flags, if any, then drops the
+// ones listed in Exclude. Listed flags the configuration doesn't define are logged rather than failing the fetch, as
+// they may be created later. The other top-level fields, such as $evaluators, are kept.
+func (rs *Sync) filterFlags(data string) (string, error) {
+	if (len(rs.Flags) == 0 && len(rs.Exclude) == 0) || data == "" {
+		return data, nil
+	}
+
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(data), &document); err != nil {
+		return "", fmt.Errorf("invalid JSON in the configuration of Redis key %s: %w", rs.Key, err)
+	}
+	var flags map[string]json.RawMessage
+	if raw, ok := document["flags"]; ok {
+		if err := json.Unmarshal(raw, &flags); err != nil {
+			return "", fmt.Errorf("invalid 'flags' object in the configuration of Redis key %s: %w", rs.Key, err)
+		}
+	}
+
+	if len(rs.Flags) > 0 {
+		kept := make(map[string]json.RawMessage, len(rs.Flags))
+		var unknown []string
+		for _, key := range rs.Flags {
+			if flag, ok := flags[key]; ok {
+				kept[key] = flag
+			} else {
+				unknown = append(unknown, key)
+			}
+		}
+		if len(unknown) > 0 {
+			rs.Logger.Warn("flags listed in the flags parameter are not defined by Redis key",
+				rs.logFields(zap.Strings("flags", unknown))...)
+		}
+		flags = kept
+	}
+	for _, key := range rs.Exclude {
+		delete(flags, key)
+	}
+	if flags == nil {
+		flags = map[string]json.RawMessage{}
+	}
+
+	raw, err := json.Marshal(flags)
+	if err != nil {
+		return "", fmt.Errorf("failed to filter the flags of Redis key %s: %w", rs.Key, err)
+	}
+	document["flags"] = raw
+
+	filtered, err := json.Marshal(document)
+	if err != nil {
+		return "", fmt.Errorf("failed to filter the flags of Redis key %s: %w", rs.Key, err)
+	}
+	return string(filtered), nil
+}
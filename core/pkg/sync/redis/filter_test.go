@@ -0,0 +1,99 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_Flags(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags", log)
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.Empty(t, rs.Flags)
+
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags&flags=checkout,+search,", log)
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.Equal(t, []string{"checkout", "search"}, rs.Flags)
+
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags&exclude=internal", log)
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.Equal(t, []string{"internal"}, rs.Exclude)
+}
+
+func TestRedisSync_fetchDataFiltersFlags(t *testing.T) {
+	document := `{"$evaluators":{"beta":{"in":["beta",{"var":"groups"}]}},"flags":{` +
+		`"checkout":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"},` +
+		`"internal":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"},` +
+		`"search":{"state":"DISABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+
+	tests := []struct {
+		name     string
+		flags    []string
+		exclude  []string
+		expected string
+	}{
+		{
+			name:     "no allowlist",
+			expected: document,
+		},
+		{
+			name:  "allowlisted flags",
+			flags: []string{"checkout", "search"},
+			expected: `{"$evaluators":{"beta":{"in":["beta",{"var":"groups"}]}},"flags":{` +
+				`"checkout":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"},` +
+				`"search":{"state":"DISABLED","variants":{"on":true},"defaultVariant":"on"}}}`,
+		},
+		{
+			name:  "unknown allowlisted flag",
+			flags: []string{"checkout", "missing"},
+			expected: `{"$evaluators":{"beta":{"in":["beta",{"var":"groups"}]}},"flags":{` +
+				`"checkout":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`,
+		},
+		{
+			name:    "excluded flags",
+			exclude: []string{"internal"},
+			expected: `{"$evaluators":{"beta":{"in":["beta",{"var":"groups"}]}},"flags":{` +
+				`"checkout":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"},` +
+				`"search":{"state":"DISABLED","variants":{"on":true},"defaultVariant":"on"}}}`,
+		},
+		{
+			name:    "allowlisted and excluded flags",
+			flags:   []string{"checkout", "internal"},
+			exclude: []string{"internal", "search"},
+			expected: `{"$evaluators":{"beta":{"in":["beta",{"var":"groups"}]}},"flags":{` +
+				`"checkout":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockRedisClient{}
+			mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(document)).Once()
+
+			rs := &Sync{
+				Client:  mockClient,
+				Logger:  logger.NewLogger(zap.NewNop(), false),
+				Key:     "test-key",
+				Flags:   tt.flags,
+				Exclude: tt.exclude,
+			}
+
+			data, err := rs.fetchData(context.Background())
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, data)
+			// the hash is the one of the filtered configuration
+			assert.Equal(t, rs.generateSHA([]byte(data)), rs.currentSHA())
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package redis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/open-feature/flagd/core/pkg/utils"
+)
+
+const (
+	// formatJSON is the default format of values read with GET
+	formatJSON = "json"
+	// formatYAML is the format query parameter value for YAML documents
+	formatYAML = "yaml"
+)
+
+// parseFormat validates the format query parameter, defaulting to JSON
+func parseFormat(value string) (string, error) {
+	switch strings.ToLower(value) {
+	case "", formatJSON:
+		return formatJSON, nil
+	case formatYAML, "yml":
+		return formatYAML, nil
+	default:
+		return "", fmt.Errorf("unsupported value for query parameter 'format': %s", value)
+	}
+}
+
+// convertToJSON converts a value read with GET from Format to JSON. Documents of the Redis JSON module are always
+// JSON and don't go through it.
+func (rs *Sync) convertToJSON(value string) (string, error) {
+	if rs.Format == formatYAML {
+		return utils.ConvertToJSON([]byte(value), ".yaml", "application/yaml")
+	}
+	return utils.ConvertToJSON([]byte(value), ".json", "application/json")
+}
@@ -0,0 +1,83 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_Format(t *testing.T) {
+	tests := []struct {
+		name           string
+		uri            string
+		expectedFormat string
+		expectError    bool
+	}{
+		{
+			name:           "default",
+			uri:            "redis://localhost:6379/0?key=flags",
+			expectedFormat: formatJSON,
+		},
+		{
+			name:           "yaml",
+			uri:            "redis://localhost:6379/0?key=flags&format=yaml",
+			expectedFormat: formatYAML,
+		},
+		{
+			name:        "unsupported",
+			uri:         "redis://localhost:6379/0?key=flags&format=toml",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, err := NewRedisSync(tt.uri, logger.NewLogger(zap.NewNop(), false))
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			defer rs.Close()
+
+			assert.Equal(t, tt.expectedFormat, rs.Format)
+		})
+	}
+}
+
+func TestRedisSync_fetchDataConvertsYAML(t *testing.T) {
+	yamlData := `flags:
+  test:
+    state: ENABLED
+    variants:
+      "on": true
+      "off": false
+    defaultVariant: "on"
+`
+
+	wrongType := &redis.JSONCmd{}
+	wrongType.SetErr(errors.New("WRONGTYPE Operation against a key holding the wrong kind of value"))
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(wrongType)
+	mockClient.On("Get", mock.Anything, "test-key").Return(redis.NewStringResult(yamlData, nil))
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "test-key",
+		Format: formatYAML,
+	}
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.JSONEq(t,
+		`{"flags":{"test":{"state":"ENABLED","variants":{"on":true,"off":false},"defaultVariant":"on"}}}`, data)
+}
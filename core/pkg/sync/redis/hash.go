@@ -0,0 +1,82 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// typeDocument is the default key type, a Redis JSON document or a string
+	typeDocument = "document"
+	// typeHash is the type query parameter value for hashes holding one flag per field
+	typeHash = "hash"
+)
+
+// parseKeyType validates the type query parameter, defaulting to documents
+func parseKeyType(value string) (string, error) {
+	switch strings.ToLower(value) {
+	case "", typeDocument:
+		return typeDocument, nil
+	case typeHash, typeStream, typeList:
+		return strings.ToLower(value), nil
+	default:
+		return "", fmt.Errorf("unsupported value for query parameter 'type': %s", value)
+	}
+}
+
+// fetchKey fetches the configuration held by key according to Type
+func (rs *Sync) fetchKey(ctx context.Context, key string) (string, error) {
+	switch rs.Type {
+	case typeHash:
+		return rs.fetchHash(ctx, key)
+	case typeStream:
+		return rs.fetchStream(ctx, key)
+	case typeList:
+		return rs.fetchList(ctx, key)
+	}
+	if len(rs.Paths) > 0 {
+		return rs.fetchPaths(ctx, key)
+	}
+	return rs.fetchDocument(ctx, key, rs.jsonPath())
+}
+
+// fetchHash assembles a configuration from the hash key, each field being a flag key and its value the flag
+// definition. A missing key returns an empty string.
+func (rs *Sync) fetchHash(ctx context.Context, key string) (string, error) {
+	var result *redis.MapStringStringCmd
+	_ = rs.withRetry(ctx, "HGETALL", func() error {
+		result = rs.client().HGetAll(ctx, key)
+		return result.Err()
+	})
+	if err := result.Err(); err != nil {
+		return "", fmt.Errorf("failed to get hash from Redis: %w", err)
+	}
+
+	fields := result.Val()
+	if len(fields) == 0 {
+		return "", nil
+	}
+
+	flags := make(map[string]json.RawMessage, len(fields))
+	for field, value := range fields {
+		value, err := rs.transformValue(key, value)
+		if err != nil {
+			return "", err
+		}
+		if !json.Valid([]byte(value)) {
+			return "", fmt.Errorf("invalid JSON for flag %s in Redis hash %s", field, key)
+		}
+		flags[field] = json.RawMessage(value)
+	}
+
+	// the fields are marshalled in sorted order, so the document and its SHA don't depend on the hash iteration
+	document, err := json.Marshal(map[string]map[string]json.RawMessage{"flags": flags})
+	if err != nil {
+		return "", fmt.Errorf("failed to assemble the flags of Redis hash %s: %w", key, err)
+	}
+	return string(document), nil
+}
@@ -0,0 +1,94 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_KeyType(t *testing.T) {
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags", logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.Equal(t, typeDocument, rs.Type)
+
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags&type=hash", logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.Equal(t, typeHash, rs.Type)
+
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags&type=stream", logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.Equal(t, typeStream, rs.Type)
+
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags&type=list", logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.Equal(t, typeList, rs.Type)
+
+	_, err = NewRedisSync("redis://localhost:6379/0?key=flags&type=set", logger.NewLogger(zap.NewNop(), false))
+	require.Error(t, err)
+}
+
+func TestRedisSync_fetchDataAssemblesHash(t *testing.T) {
+	tests := []struct {
+		name        string
+		fields      map[string]string
+		expected    string
+		expectError bool
+	}{
+		{
+			name: "one flag per field",
+			fields: map[string]string{
+				"new-ui":  `{"state":"ENABLED","variants":{"on":true,"off":false},"defaultVariant":"on"}`,
+				"dark-ui": `{"state":"DISABLED","variants":{"on":true,"off":false},"defaultVariant":"off"}`,
+			},
+			expected: `{"flags":{` +
+				`"dark-ui":{"state":"DISABLED","variants":{"on":true,"off":false},"defaultVariant":"off"},` +
+				`"new-ui":{"state":"ENABLED","variants":{"on":true,"off":false},"defaultVariant":"on"}}}`,
+		},
+		{
+			name:     "missing hash",
+			fields:   map[string]string{},
+			expected: "",
+		},
+		{
+			name:        "invalid flag definition",
+			fields:      map[string]string{"new-ui": `{"state":`},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockRedisClient{}
+			mockClient.On("HGetAll", mock.Anything, "test-key").Return(redis.NewMapStringStringResult(tt.fields, nil))
+
+			rs := &Sync{
+				Client: mockClient,
+				Logger: logger.NewLogger(zap.NewNop(), false),
+				Key:    "test-key",
+				Type:   typeHash,
+			}
+
+			data, err := rs.fetchData(context.Background())
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, data)
+			if tt.expected != "" {
+				assert.Equal(t, rs.generateSHA([]byte(tt.expected)), rs.LastSHA)
+			}
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// parseHealthInterval parses the health_interval query parameter, the interval Redis is pinged at between fetches.
+// Rebuilding the client would close the subscriptions of watch and channel, so it can't be combined with them.
+func parseHealthInterval(query url.Values, modes uriModes) (time.Duration, error) {
+	interval, err := parseDurationParam(query, "health_interval")
+	if err != nil || interval == 0 {
+		return 0, err
+	}
+
+	switch {
+	case modes.watch:
+		return 0, errors.New("query parameter 'health_interval' can't be combined with 'watch'")
+	case query.Get("channel") != "":
+		return 0, errors.New("query parameter 'health_interval' can't be combined with 'channel'")
+	}
+	return interval, nil
+}
+
+// client returns the Redis client, replaced by the health check when a ping fails
+func (rs *Sync) client() RedisClient {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+
+	return rs.Client
+}
+
+// monitorHealth pings Redis every HealthInterval until ctx is cancelled
+func (rs *Sync) monitorHealth(ctx context.Context) {
+	ticker := time.NewTicker(rs.HealthInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			rs.checkHealth(ctx)
+		}
+	}
+}
+
+// checkHealth pings Redis, marking the provider unhealthy and rebuilding the client when the ping fails, so that
+// connections that silently died are replaced before the next fetch rather than by it. The provider is healthy again
+// once a ping succeeds.
+func (rs *Sync) checkHealth(ctx context.Context) {
+	err := rs.client().Ping(ctx).Err()
+	if ctx.Err() != nil {
+		return
+	}
+
+	rs.mu.Lock()
+	wasUnhealthy := rs.unhealthy
+	rs.unhealthy = err != nil
+	rs.mu.Unlock()
+
+	if err == nil {
+		if wasUnhealthy {
+			rs.Logger.Info("Redis health check recovered", rs.logFields()...)
+		}
+		return
+	}
+
+	rs.Logger.Warn("Redis health check failed, reconnecting", rs.logFields(zap.Error(err))...)
+	rs.reconnect()
+}
+
+// reconnect replaces the client with a new one and closes the previous one, failing the commands still running on it
+func (rs *Sync) reconnect() {
+	if rs.newClient == nil {
+		return
+	}
+
+	client := rs.newClient()
+	rs.mu.Lock()
+	previous := rs.Client
+	rs.Client = client
+	rs.mu.Unlock()
+
+	if previous != nil {
+		if err := previous.Close(); err != nil {
+			rs.Logger.Debug("failed to close the previous Redis client", rs.logFields(zap.Error(err))...)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_HealthInterval(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags&health_interval=15s", log)
+	require.NoError(t, err)
+	assert.Equal(t, 15*time.Second, rs.HealthInterval)
+	assert.NotNil(t, rs.newClient)
+
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags", log)
+	require.NoError(t, err)
+	assert.Zero(t, rs.HealthInterval)
+
+	for name, uri := range map[string]string{
+		"invalid":      "redis://localhost:6379/0?key=flags&health_interval=often",
+		"negative":     "redis://localhost:6379/0?key=flags&health_interval=-1s",
+		"with watch":   "redis://localhost:6379/0?key=flags&health_interval=15s&watch=true",
+		"with channel": "redis://localhost:6379/0?key=flags&health_interval=15s&channel=flags-changed",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewRedisSync(uri, log)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestRedisSync_checkHealthReconnects(t *testing.T) {
+	broken := &MockRedisClient{}
+	broken.On("Ping", mock.Anything).Return(redis.NewStatusResult("", errors.New("connection reset"))).Once()
+	broken.On("Close").Return(nil).Once()
+	rebuilt := &MockRedisClient{}
+	rebuilt.On("Ping", mock.Anything).Return(redis.NewStatusResult("PONG", nil)).Once()
+
+	rs := &Sync{
+		Client:    broken,
+		Logger:    logger.NewLogger(zap.NewNop(), false),
+		Key:       "flags",
+		ready:     true,
+		newClient: func() RedisClient { return rebuilt },
+	}
+
+	rs.checkHealth(context.Background())
+	assert.False(t, rs.IsReady(), "a failed ping marks the provider not ready")
+	assert.Same(t, rebuilt, rs.client())
+
+	rs.checkHealth(context.Background())
+	assert.True(t, rs.IsReady(), "a successful ping marks the provider ready again")
+
+	broken.AssertExpectations(t)
+	rebuilt.AssertExpectations(t)
+}
+
+func TestRedisSync_SyncHealthCheckDegradesAndRecovers(t *testing.T) {
+	flags := `{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+
+	// the initial client serves the initial fetch, then its pings fail
+	initial := &MockRedisClient{}
+	initial.On("JSONGet", mock.Anything, "flags", mock.Anything).Return(jsonCmd(flags)).Once()
+	initial.On("Ping", mock.Anything).Return(redis.NewStatusResult("", errors.New("connection reset")))
+	initial.On("Close").Return(nil)
+	// rebuilt clients keep failing until Redis recovers
+	down := &MockRedisClient{}
+	down.On("Ping", mock.Anything).Return(redis.NewStatusResult("", errors.New("connection refused")))
+	down.On("Close").Return(nil)
+	up := &MockRedisClient{}
+	up.On("Ping", mock.Anything).Return(redis.NewStatusResult("PONG", nil))
+	up.On("Close").Return(nil)
+
+	var recovered atomic.Bool
+	mockCron := &MockCron{}
+	mockCron.On("AddFunc", "@every 30s", mock.Anything).Return(nil)
+	mockCron.On("Start").Return()
+	mockCron.On("Stop").Return()
+
+	rs := &Sync{
+		URI:            "redis://localhost:6379/0?key=flags&health_interval=5ms",
+		Client:         initial,
+		Cron:           mockCron,
+		Logger:         logger.NewLogger(zap.NewNop(), false),
+		Key:            "flags",
+		Interval:       30,
+		HealthInterval: 5 * time.Millisecond,
+		newClient: func() RedisClient {
+			if recovered.Load() {
+				return up
+			}
+			return down
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dataSync := make(chan sync.DataSync, 1)
+	synced := make(chan error, 1)
+	go func() {
+		synced <- rs.Sync(ctx, dataSync)
+	}()
+	require.Equal(t, flags, (<-dataSync).FlagData)
+
+	require.Eventually(t, func() bool { return rs.client() == down }, time.Second, time.Millisecond)
+	assert.False(t, rs.IsReady(), "the provider is degraded while pings fail")
+
+	recovered.Store(true)
+	require.Eventually(t, rs.IsReady, time.Second, time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-synced)
+	up.AssertCalled(t, "Close")
+}
@@ -0,0 +1,46 @@
+package redis
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"go.uber.org/zap"
+)
+
+// subscribeChannel subscribes to the invalidation channel. It returns nil when the subscription fails, in which
+// case the provider only polls.
+func (rs *Sync) subscribeChannel(ctx context.Context) PubSub {
+	pubsub := rs.client().Subscribe(ctx, rs.Channel)
+
+	// Wait for the subscription to be confirmed before relying on it
+	if _, err := pubsub.Receive(ctx); err != nil {
+		rs.Logger.Warn("unable to subscribe to Redis channel, polling only",
+			rs.logFields(zap.String("channel", rs.Channel), zap.Error(err))...)
+		_ = pubsub.Close()
+		return nil
+	}
+
+	return pubsub
+}
+
+// listenInvalidations fetches and emits the configuration whenever a message is published to the invalidation
+// channel, until ctx is cancelled. Polling carries on meanwhile, covering messages lost while disconnected.
+func (rs *Sync) listenInvalidations(ctx context.Context, pubsub PubSub, dataSync chan<- sync.DataSync) error {
+	messages := pubsub.Channel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-messages:
+			if !ok {
+				return errors.New("invalidation channel subscription closed")
+			}
+
+			rs.Logger.Debug("received invalidation on Redis channel",
+				rs.logFields(zap.String("channel", rs.Channel), zap.String("message", msg.Payload))...)
+			rs.poll(ctx, dataSync)
+		}
+	}
+}
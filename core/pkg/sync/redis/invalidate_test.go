@@ -0,0 +1,113 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_Channel(t *testing.T) {
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags&channel=flags-invalidate",
+		logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+	defer rs.Close()
+
+	assert.Equal(t, "flags-invalidate", rs.Channel)
+}
+
+func TestRedisSync_InvalidationMessageTriggersFetch(t *testing.T) {
+	initial := `{"flags":{"test":{"state":"ENABLED"}}}`
+	updated := `{"flags":{"test":{"state":"DISABLED"}}}`
+
+	pubsub := NewMockPubSub()
+	mockClient := &MockRedisClient{}
+	mockClient.On("Subscribe", mock.Anything, []string{"flags-invalidate"}).Return(pubsub)
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(initial)).Once()
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(updated))
+	mockClient.On("Close").Return(nil)
+
+	mockCron := &MockCron{}
+	mockCron.On("AddFunc", "@every 30s", mock.Anything).Return(nil)
+	mockCron.On("Start").Return()
+	mockCron.On("Stop").Return()
+
+	rs := &Sync{
+		URI:      "redis://localhost:6379/0?key=test-key&channel=flags-invalidate",
+		Client:   mockClient,
+		Cron:     mockCron,
+		Logger:   logger.NewLogger(zap.NewNop(), false),
+		Key:      "test-key",
+		Interval: 30,
+		Channel:  "flags-invalidate",
+		metrics:  newMetrics(),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dataSync := make(chan sync.DataSync, 1)
+	done := make(chan error)
+	go func() {
+		done <- rs.Sync(ctx, dataSync)
+	}()
+
+	assert.Equal(t, initial, (<-dataSync).FlagData)
+
+	pubsub.Publish("flags-invalidate", "invalidate")
+	select {
+	case data := <-dataSync:
+		assert.Equal(t, updated, data.FlagData)
+	case <-time.After(time.Second):
+		t.Fatal("no data emitted after invalidation message")
+	}
+
+	cancel()
+	require.NoError(t, <-done)
+	assert.True(t, pubsub.closed)
+
+	// polling carries on alongside the channel
+	mockCron.AssertExpectations(t)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_InvalidationChannelFallsBackToPolling(t *testing.T) {
+	pubsub := NewMockPubSub()
+	pubsub.receiveErr = errors.New("NOPERM")
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("Subscribe", mock.Anything, []string{"flags-invalidate"}).Return(pubsub)
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(`{"flags":{}}`))
+	mockClient.On("Close").Return(nil)
+
+	mockCron := &MockCron{}
+	mockCron.On("AddFunc", "@every 30s", mock.Anything).Return(nil)
+	mockCron.On("Start").Return()
+	mockCron.On("Stop").Return()
+
+	rs := &Sync{
+		Client:   mockClient,
+		Cron:     mockCron,
+		Logger:   logger.NewLogger(zap.NewNop(), false),
+		Key:      "test-key",
+		Interval: 30,
+		Channel:  "flags-invalidate",
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- rs.Sync(ctx, make(chan sync.DataSync, 1))
+	}()
+	require.Eventually(t, rs.IsReady, time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-done)
+	assert.True(t, pubsub.closed)
+	mockCron.AssertExpectations(t)
+}
@@ -0,0 +1,124 @@
+package redis
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// jsonModuleReprobeInterval is how long JSON.GET is skipped after the server was found to lack the Redis JSON module,
+// before probing again in case it got loaded
+const jsonModuleReprobeInterval = 10 * time.Minute
+
+// rootPath is the legacy path selecting a whole JSON document
+const rootPath = "."
+
+// jsonPath returns the path of the configuration within the synced documents
+func (rs *Sync) jsonPath() string {
+	if rs.Path == "" {
+		return rootPath
+	}
+	return rs.Path
+}
+
+// isRootPath reports whether path selects the whole document, which a plain GET can read as well
+func isRootPath(path string) bool {
+	return path == rootPath || path == "$"
+}
+
+// parseJSONModule reports whether the json_module query parameter disables the Redis JSON module, which is
+// otherwise tried first. A path within the document can only be read with the module.
+func parseJSONModule(query url.Values) (bool, error) {
+	if query.Get("json_module") == "" {
+		return false, nil
+	}
+
+	jsonModule, err := parseBoolParam(query, "json_module")
+	if err != nil {
+		return false, err
+	}
+	if path := query.Get("path"); !jsonModule && path != "" && !isRootPath(path) {
+		return false, errors.New("query parameter 'path' requires the Redis JSON module, disabled by 'json_module'")
+	}
+	return !jsonModule, nil
+}
+
+// useJSONModule reports whether path is read with JSON.GET. Once the server was found to lack the Redis JSON module,
+// documents are read with GET only until the module is probed again. A path within the document always requires
+// the module, so JSON.GET is still issued to report its error.
+func (rs *Sync) useJSONModule(path string) bool {
+	if rs.SkipJSONModule {
+		return false
+	}
+	if !isRootPath(path) {
+		return true
+	}
+
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return rs.jsonModuleMissing.IsZero() || time.Since(rs.jsonModuleMissing) >= jsonModuleReprobeInterval
+}
+
+// recordJSONModule remembers whether the result err of JSON.GET shows the server lacks the Redis JSON module
+func (rs *Sync) recordJSONModule(err error) {
+	missing := err != nil && isUnknownCommand(err)
+	if err != nil && !missing {
+		// other errors, such as a missing key or a timeout, tell nothing about the module
+		return
+	}
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if missing {
+		if rs.jsonModuleMissing.IsZero() {
+			rs.Logger.Debug("Redis JSON module unavailable, reading documents with GET",
+				rs.logFields(zap.Duration("reprobeIn", jsonModuleReprobeInterval))...)
+		}
+		rs.jsonModuleMissing = time.Now()
+		return
+	}
+	rs.jsonModuleMissing = time.Time{}
+}
+
+// recordJSONModuleUsed remembers whether the last document was read with JSON.GET, for the stats
+func (rs *Sync) recordJSONModuleUsed(used bool) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.jsonModuleUsed = used
+}
+
+// fetchMethod returns the method of the metrics reading the configuration last: the command of the key type, or for
+// documents whether JSON.GET or GET read them
+func (rs *Sync) fetchMethod() string {
+	switch rs.Type {
+	case typeHash:
+		return methodHGetAll
+	case typeStream:
+		return methodXRevRange
+	case typeList:
+		return methodLIndex
+	}
+
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.jsonModuleUsed {
+		return methodJSON
+	}
+	return methodGet
+}
+
+// firstJSONPathMatch returns the first match of the array returned by a JSONPath query, empty when nothing matched
+func firstJSONPathMatch(result string) (string, error) {
+	var matches []json.RawMessage
+	if err := json.Unmarshal([]byte(result), &matches); err != nil {
+		return "", fmt.Errorf("unexpected result of JSONPath query: %w", err)
+	}
+	if len(matches) == 0 {
+		return "", nil
+	}
+	return string(matches[0]), nil
+}
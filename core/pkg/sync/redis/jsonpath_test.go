@@ -0,0 +1,184 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRedisSync_fetchDataCustomPath(t *testing.T) {
+	subtree := `{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+
+	tests := []struct {
+		name   string
+		path   string
+		result string
+		want   string
+	}{
+		{
+			name:   "legacy path",
+			path:   ".featureFlags",
+			result: subtree,
+			want:   subtree,
+		},
+		{
+			name:   "JSONPath",
+			path:   "$.featureFlags",
+			result: "[" + subtree + "]",
+			want:   subtree,
+		},
+		{
+			name:   "JSONPath without match",
+			path:   "$.featureFlags",
+			result: "[]",
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockRedisClient{}
+			mockClient.On("JSONGet", mock.Anything, "config", []string{tt.path}).Return(jsonCmd(tt.result))
+
+			rs := &Sync{
+				Client: mockClient,
+				Logger: logger.NewLogger(zap.NewNop(), false),
+				Key:    "config",
+				Path:   tt.path,
+			}
+
+			data, err := rs.fetchData(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, data)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRedisSync_fetchDataCustomPathRequiresJSONModule(t *testing.T) {
+	unavailable := &redis.JSONCmd{}
+	unavailable.SetErr(errors.New("ERR unknown command 'JSON.GET'"))
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "config", []string{".featureFlags"}).Return(unavailable)
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "config",
+		Path:   ".featureFlags",
+	}
+
+	_, err := rs.fetchData(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "JSON path .featureFlags requires the Redis JSON module")
+
+	// a plain GET can't extract the path, so it isn't attempted
+	mockClient.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+}
+
+func TestNewRedisSync_Path(t *testing.T) {
+	rs, err := NewRedisSync("redis://localhost:6379?key=config&path=.featureFlags", logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+	defer rs.Close()
+
+	assert.Equal(t, ".featureFlags", rs.Path)
+	assert.Equal(t, ".featureFlags", rs.jsonPath())
+	assert.Equal(t, rootPath, (&Sync{}).jsonPath())
+}
+
+func TestRedisSync_fetchDataSkipsJSONModule(t *testing.T) {
+	document := `{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("Get", mock.Anything, "config").Return(redis.NewStringResult(document, nil))
+
+	rs := &Sync{
+		Client:         mockClient,
+		Logger:         logger.NewLogger(zap.NewNop(), false),
+		Key:            "config",
+		SkipJSONModule: true,
+	}
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.JSONEq(t, document, data)
+	mockClient.AssertNotCalled(t, "JSONGet", mock.Anything, mock.Anything, mock.Anything)
+	mockClient.AssertExpectations(t)
+}
+
+func TestNewRedisSync_JSONModule(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		expectSkip  bool
+		expectError bool
+	}{
+		{name: "auto-detect by default", query: "key=config"},
+		{name: "enabled", query: "key=config&json_module=true"},
+		{name: "disabled", query: "key=config&json_module=false", expectSkip: true},
+		{name: "disabled with root path", query: "key=config&json_module=false&path=$", expectSkip: true},
+		{name: "disabled with path", query: "key=config&json_module=false&path=.featureFlags", expectError: true},
+		{name: "invalid", query: "key=config&json_module=maybe", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, err := NewRedisSync("redis://localhost:6379?"+tt.query, logger.NewLogger(zap.NewNop(), false))
+			if tt.expectError {
+				require.ErrorContains(t, err, "json_module")
+				return
+			}
+			require.NoError(t, err)
+			defer rs.Close()
+
+			assert.Equal(t, tt.expectSkip, rs.SkipJSONModule)
+		})
+	}
+}
+
+func TestRedisSync_fetchDataCachesMissingJSONModule(t *testing.T) {
+	document := `{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+	unavailable := &redis.JSONCmd{}
+	unavailable.SetErr(errors.New("ERR unknown command 'JSON.GET', with args beginning with: 'config' '.'"))
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "config", []string{rootPath}).Return(unavailable).Once()
+	mockClient.On("Get", mock.Anything, "config").Return(redis.NewStringResult(document, nil))
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "config",
+	}
+
+	for range 2 {
+		data, err := rs.fetchData(context.Background())
+		require.NoError(t, err)
+		assert.JSONEq(t, document, data)
+	}
+	// the second fetch skips JSON.GET, knowing the module is missing
+	mockClient.AssertNumberOfCalls(t, "JSONGet", 1)
+	mockClient.AssertNumberOfCalls(t, "Get", 2)
+	assert.False(t, rs.Stats().JSONModule)
+
+	// once the re-probe interval elapsed, JSON.GET is tried again and picks up the loaded module
+	rs.jsonModuleMissing = time.Now().Add(-jsonModuleReprobeInterval)
+	mockClient.On("JSONGet", mock.Anything, "config", []string{rootPath}).Return(jsonCmd(document)).Once()
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.JSONEq(t, document, data)
+	mockClient.AssertNumberOfCalls(t, "JSONGet", 2)
+	mockClient.AssertNumberOfCalls(t, "Get", 2)
+	assert.True(t, rs.jsonModuleMissing.IsZero())
+	assert.True(t, rs.Stats().JSONModule)
+}
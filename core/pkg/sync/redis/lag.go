@@ -0,0 +1,68 @@
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// lastModifiedField is the optional document field carrying the time the configuration was written
+const lastModifiedField = "lastModified"
+
+// timestampedDocument captures the locations a lastModified timestamp may be stored at
+type timestampedDocument struct {
+	LastModified json.RawMessage `json:"lastModified"`
+	Metadata     struct {
+		LastModified json.RawMessage `json:"lastModified"`
+	} `json:"metadata"`
+}
+
+// computeSyncLag returns how far behind the document's lastModified timestamp the given instant is.
+// The boolean is false when the document carries no usable timestamp.
+func computeSyncLag(data string, now time.Time) (time.Duration, bool, error) {
+	var doc timestampedDocument
+	if err := json.Unmarshal([]byte(data), &doc); err != nil {
+		return 0, false, fmt.Errorf("unable to parse document: %w", err)
+	}
+
+	raw := doc.LastModified
+	if len(raw) == 0 {
+		raw = doc.Metadata.LastModified
+	}
+	if len(raw) == 0 {
+		return 0, false, nil
+	}
+
+	modified, err := parseTimestamp(raw)
+	if err != nil {
+		return 0, false, err
+	}
+
+	lag := now.Sub(modified)
+	if lag < 0 {
+		// clocks of the writer and flagd disagree, a document cannot be modified in the future
+		lag = 0
+	}
+
+	return lag, true, nil
+}
+
+// parseTimestamp accepts either an RFC 3339 string or a numeric unix timestamp in seconds
+func parseTimestamp(raw json.RawMessage) (time.Time, error) {
+	var str string
+	if err := json.Unmarshal(raw, &str); err == nil {
+		modified, err := time.Parse(time.RFC3339Nano, str)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid %s timestamp %q: %w", lastModifiedField, str, err)
+		}
+		return modified, nil
+	}
+
+	seconds, err := strconv.ParseFloat(string(raw), 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s timestamp %s", lastModifiedField, string(raw))
+	}
+
+	return time.Unix(0, int64(seconds*float64(time.Second))), nil
+}
@@ -0,0 +1,98 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestComputeSyncLag(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 30, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		data        string
+		expectedLag time.Duration
+		expectKnown bool
+		expectError bool
+	}{
+		{
+			name:        "RFC 3339 timestamp",
+			data:        `{"lastModified":"2024-05-01T12:00:00Z","flags":{}}`,
+			expectedLag: 30 * time.Second,
+			expectKnown: true,
+		},
+		{
+			name:        "unix timestamp",
+			data:        fmt.Sprintf(`{"lastModified":%d,"flags":{}}`, now.Add(-5*time.Second).Unix()),
+			expectedLag: 5 * time.Second,
+			expectKnown: true,
+		},
+		{
+			name:        "timestamp in metadata",
+			data:        `{"metadata":{"lastModified":"2024-05-01T12:00:20Z"},"flags":{}}`,
+			expectedLag: 10 * time.Second,
+			expectKnown: true,
+		},
+		{
+			name:        "timestamp in the future",
+			data:        `{"lastModified":"2024-05-01T12:01:00Z","flags":{}}`,
+			expectedLag: 0,
+			expectKnown: true,
+		},
+		{
+			name: "absent timestamp",
+			data: `{"flags":{}}`,
+		},
+		{
+			name:        "invalid timestamp",
+			data:        `{"lastModified":"yesterday","flags":{}}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lag, known, err := computeSyncLag(tt.data, now)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectKnown, known)
+			assert.Equal(t, tt.expectedLag, lag)
+		})
+	}
+}
+
+func TestRedisSync_SyncLag(t *testing.T) {
+	modified := time.Now().Add(-42 * time.Second).UTC().Format(time.RFC3339)
+
+	mockClient := &MockRedisClient{}
+	jsonCmd := &redis.JSONCmd{}
+	jsonCmd.SetVal(fmt.Sprintf(`{"lastModified":"%s","flags":{}}`, modified))
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd)
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "test-key",
+	}
+
+	_, known := rs.SyncLag()
+	assert.False(t, known)
+
+	_, err := rs.fetchData(context.Background())
+	assert.NoError(t, err)
+
+	lag, known := rs.SyncLag()
+	assert.True(t, known)
+	assert.InDelta(t, 42, lag, 2)
+}
@@ -0,0 +1,31 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// typeList is the type query parameter value for lists whose elements are revisions of the configuration, the
+// newest at the head
+const typeList = "list"
+
+// fetchList returns the configuration held by the head element of the list key, read like a value read with GET. A
+// missing key or an empty list returns an empty string.
+func (rs *Sync) fetchList(ctx context.Context, key string) (string, error) {
+	var result *redis.StringCmd
+	_ = rs.withRetry(ctx, "LINDEX", func() error {
+		result = rs.client().LIndex(ctx, key, 0)
+		return result.Err()
+	})
+	if err := result.Err(); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read list from Redis: %w", err)
+	}
+
+	return rs.decodeGet(key, result.Val())
+}
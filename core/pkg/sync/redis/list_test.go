@@ -0,0 +1,89 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRedisSync_fetchDataReadsListHead(t *testing.T) {
+	latest := `{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+
+	tests := []struct {
+		name      string
+		head      *redis.StringCmd
+		expected  string
+		expectErr string
+	}{
+		{
+			name:     "head element",
+			head:     redis.NewStringResult(latest, nil),
+			expected: latest,
+		},
+		{
+			// LINDEX replies nil for an empty list as for a missing key
+			name:     "empty list",
+			head:     redis.NewStringResult("", redis.Nil),
+			expected: "",
+		},
+		{
+			name:      "read failure",
+			head:      redis.NewStringResult("", errors.New("WRONGTYPE Operation against a key holding the wrong kind")),
+			expectErr: "failed to read list from Redis",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockRedisClient{}
+			mockClient.On("LIndex", mock.Anything, "test-key", int64(0)).Return(tt.head)
+
+			rs := &Sync{
+				Client: mockClient,
+				Logger: logger.NewLogger(zap.NewNop(), false),
+				Key:    "test-key",
+				Type:   typeList,
+			}
+
+			data, err := rs.fetchData(context.Background())
+			if tt.expectErr != "" {
+				require.ErrorContains(t, err, tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, data)
+			assert.Equal(t, methodLIndex, rs.fetchMethod())
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRedisSync_fetchDataMergesLists(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("LIndex", mock.Anything, "team-a", int64(0)).Return(redis.NewStringResult(
+		`{"flags":{"a":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`, nil))
+	mockClient.On("LIndex", mock.Anything, "team-b", int64(0)).Return(redis.NewStringResult(
+		`{"flags":{"b":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`, nil))
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "team-a",
+		Keys:   []string{"team-a", "team-b"},
+		Type:   typeList,
+	}
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"flags":{`+
+		`"a":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"},`+
+		`"b":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`, data)
+	mockClient.AssertExpectations(t)
+}
@@ -0,0 +1,25 @@
+package redis
+
+import "go.uber.org/zap"
+
+// redactedURI renders a Redis URI without its password, only once a log entry is actually written
+type redactedURI string
+
+func (u redactedURI) String() string {
+	return RedactURI(string(u))
+}
+
+// logFields returns the structured fields identifying the synced key and its source in log entries, followed by
+// fields
+func (rs *Sync) logFields(fields ...zap.Field) []zap.Field {
+	return append(sourceFields(rs.URI, rs.Key, rs.Name), fields...)
+}
+
+// sourceFields returns the structured fields identifying key synced from uri, by name when set
+func sourceFields(uri, key, name string) []zap.Field {
+	source := zap.Stringer("source", redactedURI(uri))
+	if name != "" {
+		source = zap.String("source", name)
+	}
+	return []zap.Field{zap.String("key", key), source}
+}
@@ -0,0 +1,61 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRedisSync_pollLogsStructuredFields(t *testing.T) {
+	document := `{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+
+	unreachableJSON := &redis.JSONCmd{}
+	unreachableJSON.SetErr(errors.New("connection refused"))
+	unreachableGet := redis.NewStringCmd(context.Background())
+	unreachableGet.SetErr(errors.New("connection refused"))
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).Return(jsonCmd(document)).Once()
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).Return(unreachableJSON).Once()
+	mockClient.On("Get", mock.Anything, "flags").Return(unreachableGet).Once()
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	rs := &Sync{
+		URI:    "redis://:secret@localhost:6379/0?key=flags",
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.New(core), false),
+		Key:    "flags",
+	}
+
+	dataSync := make(chan sync.DataSync, 1)
+	rs.poll(context.Background(), dataSync)
+
+	created := logs.FilterMessage("configuration created").All()
+	require.Len(t, created, 1)
+	fields := created[0].ContextMap()
+	assert.Equal(t, "flags", fields["key"])
+	assert.Equal(t, RedactURI(rs.URI), fields["source"])
+	assert.NotContains(t, fields["source"], "secret")
+	assert.Equal(t, rs.LastSHA, fields["sha"])
+	assert.EqualValues(t, len(document), fields["byteSize"])
+	assert.Contains(t, fields, "duration")
+
+	rs.poll(context.Background(), dataSync)
+
+	failed := logs.FilterMessage("error fetching from Redis").All()
+	require.Len(t, failed, 1)
+	fields = failed[0].ContextMap()
+	assert.Equal(t, "flags", fields["key"])
+	assert.Contains(t, fields["error"], "connection refused")
+	assert.Contains(t, fields, "duration")
+}
@@ -0,0 +1,157 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// mergedSections are the top-level objects merged key by key across documents, other fields are overridden whole
+var mergedSections = []string{"flags", "$evaluators"}
+
+// syncedKeys returns the keys holding the configuration, in merge order
+func (rs *Sync) syncedKeys() []string {
+	if len(rs.Keys) == 0 {
+		return []string{rs.Key}
+	}
+	return rs.Keys
+}
+
+// fetchKeys fetches the configuration of every key, an empty string for missing keys. Documents are fetched in a
+// single round trip unless the server refuses it or they are read at several paths, other key types one key at a
+// time.
+func (rs *Sync) fetchKeys(ctx context.Context, keys []string) ([]string, error) {
+	if rs.Type != typeHash && rs.Type != typeStream && rs.Type != typeList && len(rs.Paths) == 0 {
+		fetched, err := rs.fetchDocuments(ctx, keys)
+		if err == nil || !isBatchUnsupported(err) {
+			return fetched, err
+		}
+		rs.Logger.Debug("fetching the Redis keys one at a time, MGET is unsupported", rs.logFields(zap.Error(err))...)
+	}
+
+	fetched := make([]string, len(keys))
+	for i, key := range keys {
+		data, err := rs.fetchKey(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch Redis key %s: %w", key, err)
+		}
+		fetched[i] = data
+	}
+	return fetched, nil
+}
+
+// fetchMerged fetches every key and merges their documents into a single configuration, keys later in the list
+// overriding earlier ones. Missing keys are skipped.
+func (rs *Sync) fetchMerged(ctx context.Context, keys []string) (string, error) {
+	fetched, err := rs.fetchKeys(ctx, keys)
+	if err != nil {
+		return "", err
+	}
+
+	var documents []string
+	var sources []string
+	for i, data := range fetched {
+		if data != "" {
+			documents = append(documents, data)
+			sources = append(sources, keys[i])
+		}
+	}
+
+	if len(documents) == 0 {
+		return "", nil
+	}
+	if len(documents) == 1 {
+		return documents[0], nil
+	}
+
+	return rs.mergeDocuments(documents, sources)
+}
+
+// mergeDocuments deep-merges the documents of the keys, warning about flags defined more than once
+func (rs *Sync) mergeDocuments(documents []string, keys []string) (string, error) {
+	sources := make([]string, len(keys))
+	for i, key := range keys {
+		sources[i] = "Redis key " + key
+	}
+
+	return MergeDocuments(documents, sources, func(flag, source, previous string) {
+		rs.Logger.Warn("flag overrides an earlier definition", rs.logFields(zap.String("flag", flag),
+			zap.String("from", source), zap.String("overrides", previous))...)
+	})
+}
+
+// MergeDocuments deep-merges the flags and evaluators of the flag configurations, later documents overriding flags
+// and evaluators defined earlier and other top-level fields whole. sources names the documents in errors, and
+// onOverride, when set, is called for every flag defined again by a later document. Values are kept as raw JSON, so
+// they go through unchanged.
+func MergeDocuments(
+	documents []string,
+	sources []string,
+	onOverride func(flag, source, previous string),
+) (string, error) {
+	return mergeSections(documents, sources, mergedSections, onOverride)
+}
+
+// mergeSections deep-merges the named top-level objects of the documents, overriding other fields whole
+func mergeSections(
+	documents []string,
+	sources []string,
+	names []string,
+	onOverride func(flag, source, previous string),
+) (string, error) {
+	merged := map[string]json.RawMessage{}
+	sections := map[string]map[string]json.RawMessage{}
+	flagSources := map[string]string{}
+
+	for i, data := range documents {
+		var document map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(data), &document); err != nil {
+			return "", fmt.Errorf("invalid JSON in %s: %w", sources[i], err)
+		}
+
+		for field, value := range document {
+			merged[field] = value
+		}
+
+		for _, name := range names {
+			raw, ok := document[name]
+			if !ok {
+				continue
+			}
+
+			var entries map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &entries); err != nil {
+				return "", fmt.Errorf("invalid '%s' object in %s: %w", name, sources[i], err)
+			}
+			if sections[name] == nil {
+				sections[name] = map[string]json.RawMessage{}
+			}
+
+			for key, value := range entries {
+				if name == "flags" {
+					if previous, ok := flagSources[key]; ok && onOverride != nil {
+						onOverride(key, sources[i], previous)
+					}
+					flagSources[key] = sources[i]
+				}
+				sections[name][key] = value
+			}
+		}
+	}
+
+	for name, entries := range sections {
+		raw, err := json.Marshal(entries)
+		if err != nil {
+			return "", fmt.Errorf("failed to merge '%s' objects: %w", name, err)
+		}
+		merged[name] = raw
+	}
+
+	result, err := json.Marshal(merged)
+	if err != nil {
+		return "", fmt.Errorf("failed to merge documents: %w", err)
+	}
+	return string(result), nil
+}
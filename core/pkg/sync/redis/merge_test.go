@@ -0,0 +1,90 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRedisSync_MergesMultipleKeys(t *testing.T) {
+	tests := []struct {
+		name             string
+		teamA            string
+		teamB            string
+		expected         string
+		expectedWarnings int
+	}{
+		{
+			name:  "disjoint flags are merged",
+			teamA: `{"flags":{"a":{"state":"ENABLED"}},"$evaluators":{"emailWithFaas":{"in":["@faas.com",{"var":["email"]}]}}}`,
+			teamB: `{"flags":{"b":{"state":"DISABLED","variants":{"big":9007199254740993}}}}`,
+			expected: `{"$evaluators":{"emailWithFaas":{"in":["@faas.com",{"var":["email"]}]}},` +
+				`"flags":{"a":{"state":"ENABLED"},"b":{"state":"DISABLED","variants":{"big":9007199254740993}}}}`,
+		},
+		{
+			name:             "later keys override overlapping flags",
+			teamA:            `{"flags":{"a":{"state":"ENABLED"},"shared":{"state":"ENABLED"}}}`,
+			teamB:            `{"flags":{"shared":{"state":"DISABLED"}}}`,
+			expected:         `{"flags":{"a":{"state":"ENABLED"},"shared":{"state":"DISABLED"}}}`,
+			expectedWarnings: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockRedisClient{}
+			mockClient.On("JSONMGet", mock.Anything, rootPath, []string{"team-a", "team-b"}).
+				Return(jsonSliceCmd(tt.teamA, tt.teamB))
+
+			core, logs := observer.New(zapcore.WarnLevel)
+			rs := &Sync{
+				Client: mockClient,
+				Logger: logger.NewLogger(zap.New(core), false),
+				Key:    "team-a",
+				Keys:   []string{"team-a", "team-b"},
+			}
+
+			data, err := rs.fetchData(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, data)
+			assert.Equal(t, rs.generateSHA([]byte(data)), rs.LastSHA)
+			assert.Len(t, logs.All(), tt.expectedWarnings)
+		})
+	}
+}
+
+func TestRedisSync_MergeSkipsMissingKeys(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONMGet", mock.Anything, rootPath, []string{"team-a", "team-b"}).
+		Return(jsonSliceCmd(`{"flags":{"a":{}}}`, nil))
+	mockClient.On("MGet", mock.Anything, []string{"team-b"}).Return(redis.NewSliceResult([]interface{}{nil}, nil))
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "team-a",
+		Keys:   []string{"team-a", "team-b"},
+	}
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, `{"flags":{"a":{}}}`, data)
+}
+
+func TestNewRedisSync_MultipleKeys(t *testing.T) {
+	rs, err := NewRedisSync("redis://localhost:6379/3?key=team-a&key=team-b", logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+	defer rs.Close()
+
+	assert.Equal(t, "team-a", rs.Key)
+	assert.Equal(t, []string{"team-a", "team-b"}, rs.Keys)
+	assert.Equal(t, []string{"__keyspace@3__:team-a", "__keyspace@3__:team-b"}, rs.keyspaceChannels())
+}
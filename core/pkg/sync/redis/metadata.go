@@ -0,0 +1,53 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// metadataSections are the top-level objects the metadata document contributes to the configuration, merged key by
+// key over those of the flag keys
+var metadataSections = []string{"$evaluators", "metadata"}
+
+// withMetadata merges the $evaluators and metadata of the MetadataKey document into the configuration data, other
+// fields of the metadata document, flags included, being ignored. A missing metadata document leaves data unchanged,
+// as does a missing configuration, so that the flag keys alone decide whether the configuration exists.
+func (rs *Sync) withMetadata(ctx context.Context, data string) (string, error) {
+	if rs.MetadataKey == "" || data == "" {
+		return data, nil
+	}
+
+	metadata, err := rs.fetchDocument(ctx, rs.MetadataKey, rootPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch Redis metadata key %s: %w", rs.MetadataKey, err)
+	}
+	if metadata == "" {
+		return data, nil
+	}
+
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(metadata), &document); err != nil {
+		return "", fmt.Errorf("invalid JSON in Redis metadata key %s: %w", rs.MetadataKey, err)
+	}
+	sections := make(map[string]json.RawMessage, len(metadataSections))
+	for _, name := range metadataSections {
+		if raw, ok := document[name]; ok {
+			sections[name] = raw
+		}
+	}
+	if len(sections) == 0 {
+		return data, nil
+	}
+
+	contributed, err := json.Marshal(sections)
+	if err != nil {
+		return "", fmt.Errorf("failed to read Redis metadata key %s: %w", rs.MetadataKey, err)
+	}
+	return mergeSections(
+		[]string{data, string(contributed)},
+		[]string{"Redis key " + rs.Key, "Redis metadata key " + rs.MetadataKey},
+		metadataSections,
+		nil,
+	)
+}
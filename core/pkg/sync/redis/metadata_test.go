@@ -0,0 +1,90 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_MetadataKey(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags&metadata_key=shared&prefix=tenant:acme:", log)
+	require.NoError(t, err)
+	assert.Equal(t, "tenant:acme:shared", rs.MetadataKey)
+	assert.Equal(t, []string{"tenant:acme:flags"}, rs.syncedKeys())
+	assert.Equal(t, []string{"__keyspace@0__:tenant:acme:flags", "__keyspace@0__:tenant:acme:shared"},
+		rs.keyspaceChannels())
+
+	rs.SetKeyPrefix("tenant:other:")
+	assert.Equal(t, "tenant:other:shared", rs.MetadataKey)
+}
+
+func TestRedisSync_fetchDataMergesMetadataKey(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).Return(jsonCmd(
+		`{"flags":{"a":{"state":"ENABLED"}},"$evaluators":{"local":{"in":["x"]}},"metadata":{"team":"a"}}`,
+	)).Once()
+	// the flags of the metadata document are ignored, its evaluators and metadata merged key by key
+	mockClient.On("JSONGet", mock.Anything, "shared", mock.Anything).Return(jsonCmd(
+		`{"flags":{"b":{"state":"ENABLED"}},"$evaluators":{"shared":{"in":["y"]}},"metadata":{"version":"2"}}`,
+	)).Once()
+
+	rs := &Sync{
+		Client:      mockClient,
+		Logger:      logger.NewLogger(zap.NewNop(), false),
+		Key:         "flags",
+		MetadataKey: "shared",
+	}
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"flags":{"a":{"state":"ENABLED"}},
+		"$evaluators":{"local":{"in":["x"]},"shared":{"in":["y"]}},
+		"metadata":{"team":"a","version":"2"}
+	}`, data)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_fetchDataMissingMetadataKey(t *testing.T) {
+	flags := `{"flags":{"a":{"state":"ENABLED"}}}`
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).Return(jsonCmd(flags)).Once()
+	missingKey(mockClient, "shared")
+
+	rs := &Sync{
+		Client:      mockClient,
+		Logger:      logger.NewLogger(zap.NewNop(), false),
+		Key:         "flags",
+		MetadataKey: "shared",
+	}
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, flags, data)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_fetchDataInvalidMetadataKey(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).
+		Return(jsonCmd(`{"flags":{"a":{"state":"ENABLED"}}}`)).Once()
+	mockClient.On("JSONGet", mock.Anything, "shared", mock.Anything).Return(jsonCmd(`{"$evaluators":`)).Once()
+
+	rs := &Sync{
+		Client:      mockClient,
+		Logger:      logger.NewLogger(zap.NewNop(), false),
+		Key:         "flags",
+		MetadataKey: "shared",
+	}
+
+	_, err := rs.fetchData(context.Background())
+	require.ErrorContains(t, err, "Redis metadata key shared")
+}
@@ -0,0 +1,171 @@
+package redis
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	metricsNamespace = "flagd"
+	metricsSubsystem = "redis_sync"
+)
+
+// Values of the method label, the command that read the configuration
+const (
+	methodJSON      = "json"
+	methodGet       = "get"
+	methodHGetAll   = "hgetall"
+	methodXRevRange = "xrevrange"
+	methodLIndex    = "lindex"
+)
+
+// metrics holds the Prometheus collectors of a Redis sync provider, registered on a dedicated registry
+type metrics struct {
+	registry       *prometheus.Registry
+	fetchSuccesses *prometheus.CounterVec
+	fetchFailures  prometheus.Counter
+	keyNotFound    prometheus.Counter
+	configUpdates  prometheus.Counter
+	invalidConfigs prometheus.Counter
+	fetchDuration  *prometheus.HistogramVec
+	syncLag        prometheus.Gauge
+}
+
+func newMetrics() *metrics {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		fetchSuccesses: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "fetch_success_total",
+			Help:      "Number of successful fetches of the flag configuration, labelled by the method reading it",
+		}, []string{"method"}),
+		fetchFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "fetch_failure_total",
+			Help:      "Number of failed fetches of the flag configuration",
+		}),
+		keyNotFound: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "key_not_found_total",
+			Help:      "Number of fetches finding the key missing or empty",
+		}),
+		configUpdates: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "config_update_total",
+			Help:      "Number of created or changed configurations emitted by polling",
+		}),
+		invalidConfigs: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "validation_failure_total",
+			Help:      "Number of fetched documents rejected as invalid flag configurations",
+		}),
+		fetchDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "fetch_duration_seconds",
+			Help:      "Duration of fetches of the flag configuration, labelled by the method reading it",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		syncLag: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "sync_lag_seconds",
+			Help:      "How far the last fetched configuration trails its lastModified timestamp",
+		}),
+	}
+
+	// Documents are read with JSON.GET or GET, reporting both from the start shows the fallback rate even when zero
+	for _, method := range []string{methodJSON, methodGet} {
+		m.fetchSuccesses.WithLabelValues(method)
+		m.fetchDuration.WithLabelValues(method)
+	}
+
+	m.registry.MustRegister(m.collectors()...)
+
+	return m
+}
+
+// collectors returns every collector of the provider
+func (m *metrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{m.fetchSuccesses, m.fetchFailures, m.keyNotFound, m.configUpdates, m.invalidConfigs,
+		m.fetchDuration, m.syncLag}
+}
+
+// The recording methods accept a nil receiver so that providers built without metrics keep working
+
+// observeFetch records the duration and outcome of a fetch by method
+func (m *metrics) observeFetch(method string, duration time.Duration, data string, err error) {
+	if m == nil {
+		return
+	}
+
+	m.fetchDuration.WithLabelValues(method).Observe(duration.Seconds())
+	switch {
+	case err != nil:
+		m.fetchFailures.Inc()
+	case data == "":
+		m.keyNotFound.Inc()
+	default:
+		m.fetchSuccesses.WithLabelValues(method).Inc()
+	}
+}
+
+// configUpdated records the emission of a created or changed configuration
+func (m *metrics) configUpdated() {
+	if m == nil {
+		return
+	}
+	m.configUpdates.Inc()
+}
+
+// validationFailed records the rejection of an invalid configuration
+func (m *metrics) validationFailed() {
+	if m == nil {
+		return
+	}
+	m.invalidConfigs.Inc()
+}
+
+// setSyncLag records the sync lag of the last fetched configuration
+func (m *metrics) setSyncLag(lag time.Duration) {
+	if m == nil {
+		return
+	}
+	m.syncLag.Set(lag.Seconds())
+}
+
+// MetricsHandler returns an HTTP handler serving the provider's metrics in the Prometheus exposition format
+func (rs *Sync) MetricsHandler() http.Handler {
+	if rs.metrics == nil {
+		return promhttp.HandlerFor(prometheus.NewRegistry(), promhttp.HandlerOpts{})
+	}
+	return promhttp.HandlerFor(rs.metrics.registry, promhttp.HandlerOpts{})
+}
+
+// CombinedMetrics gathers the metrics of several providers together, each labelled with the name of its provider as
+// source, see SourceName. The providers must have distinct names.
+func CombinedMetrics(syncs ...*Sync) prometheus.Gatherer {
+	registry := prometheus.NewRegistry()
+	for _, rs := range syncs {
+		if rs.metrics == nil {
+			continue
+		}
+		registerer := prometheus.WrapRegistererWith(prometheus.Labels{"source": rs.SourceName()}, registry)
+		registerer.MustRegister(rs.metrics.collectors()...)
+	}
+	return registry
+}
+
+// CombinedMetricsHandler returns an HTTP handler serving the metrics of several providers together, see
+// CombinedMetrics
+func CombinedMetricsHandler(syncs ...*Sync) http.Handler {
+	return promhttp.HandlerFor(CombinedMetrics(syncs...), promhttp.HandlerOpts{})
+}
@@ -0,0 +1,211 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newMetricsTestSync(client RedisClient) *Sync {
+	return &Sync{
+		URI:     "redis://localhost:6379/0?key=test-key",
+		Client:  client,
+		Logger:  logger.NewLogger(zap.NewNop(), false),
+		Key:     "test-key",
+		metrics: newMetrics(),
+	}
+}
+
+func TestRedisSync_fetchDataRecordsMetrics(t *testing.T) {
+	flagData := `{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+
+	tests := []struct {
+		name        string
+		setupMock   func(*MockRedisClient)
+		successes   float64
+		failures    float64
+		keyNotFound float64
+	}{
+		{
+			name: "successful fetch",
+			setupMock: func(m *MockRedisClient) {
+				m.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(flagData))
+			},
+			successes: 1,
+		},
+		{
+			name: "failed fetch",
+			setupMock: func(m *MockRedisClient) {
+				failedJSON := &redis.JSONCmd{}
+				failedJSON.SetErr(errors.New("connection error"))
+				m.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(failedJSON)
+
+				failedGet := redis.NewStringCmd(context.Background())
+				failedGet.SetErr(errors.New("connection error"))
+				m.On("Get", mock.Anything, "test-key").Return(failedGet)
+			},
+			failures: 1,
+		},
+		{
+			name: "key not found",
+			setupMock: func(m *MockRedisClient) {
+				missingJSON := &redis.JSONCmd{}
+				missingJSON.SetErr(redis.Nil)
+				m.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(missingJSON)
+
+				missingGet := redis.NewStringCmd(context.Background())
+				missingGet.SetErr(redis.Nil)
+				m.On("Get", mock.Anything, "test-key").Return(missingGet)
+			},
+			keyNotFound: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockRedisClient{}
+			tt.setupMock(mockClient)
+			rs := newMetricsTestSync(mockClient)
+
+			_, _ = rs.fetchData(context.Background())
+
+			assert.InDelta(t, tt.successes, testutil.ToFloat64(rs.metrics.fetchSuccesses.WithLabelValues(methodJSON)), 0)
+			assert.InDelta(t, tt.failures, testutil.ToFloat64(rs.metrics.fetchFailures), 0)
+			assert.InDelta(t, tt.keyNotFound, testutil.ToFloat64(rs.metrics.keyNotFound), 0)
+			assert.Equal(t, 2, testutil.CollectAndCount(rs.metrics.fetchDuration))
+		})
+	}
+}
+
+func TestRedisSync_PollRecordsConfigUpdates(t *testing.T) {
+	initial := `{"flags":{"test":{"state":"ENABLED"}}}`
+	updated := `{"flags":{"test":{"state":"DISABLED"}}}`
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(initial)).Twice()
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(updated))
+	rs := newMetricsTestSync(mockClient)
+
+	dataSync := make(chan sync.DataSync, 3)
+	for i := 0; i < 3; i++ {
+		rs.poll(context.Background(), dataSync)
+	}
+
+	// the unchanged second fetch isn't an update
+	assert.Len(t, dataSync, 2)
+	assert.InDelta(t, 2, testutil.ToFloat64(rs.metrics.configUpdates), 0)
+	assert.InDelta(t, 3, testutil.ToFloat64(rs.metrics.fetchSuccesses.WithLabelValues(methodJSON)), 0)
+}
+
+func TestRedisSync_MetricsHandler(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(`{"flags":{}}`))
+	rs := newMetricsTestSync(mockClient)
+
+	_, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	rs.MetricsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `flagd_redis_sync_fetch_success_total{method="json"} 1`)
+	assert.Contains(t, recorder.Body.String(), `flagd_redis_sync_fetch_duration_seconds_count{method="json"} 1`)
+}
+
+func TestCombinedMetricsHandler(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(`{"flags":{}}`))
+	first := newMetricsTestSync(mockClient)
+	second := newMetricsTestSync(mockClient)
+	second.URI = "redis://other:6379/0?key=test-key"
+
+	_, err := first.fetchData(context.Background())
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	CombinedMetricsHandler(first, second, &Sync{}).ServeHTTP(recorder,
+		httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(),
+		`flagd_redis_sync_fetch_success_total{method="json",source="redis://localhost:6379/0?key=test-key"} 1`)
+	assert.Contains(t, recorder.Body.String(),
+		`flagd_redis_sync_fetch_success_total{method="json",source="redis://other:6379/0?key=test-key"} 0`)
+}
+
+func TestRedisSync_fetchDataLabelsMetricsByMethod(t *testing.T) {
+	flagData := `{"flags":{"test":{"state":"ENABLED"}}}`
+
+	tests := []struct {
+		name      string
+		keyType   string
+		setupMock func(*MockRedisClient)
+		method    string
+	}{
+		{
+			name: "JSON.GET",
+			setupMock: func(m *MockRedisClient) {
+				m.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(flagData))
+			},
+			method: methodJSON,
+		},
+		{
+			name: "GET fallback",
+			setupMock: func(m *MockRedisClient) {
+				unknown := &redis.JSONCmd{}
+				unknown.SetErr(errors.New("ERR unknown command 'JSON.GET'"))
+				m.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(unknown)
+				m.On("Get", mock.Anything, "test-key").Return(redis.NewStringResult(flagData, nil))
+			},
+			method: methodGet,
+		},
+		{
+			name:    "hash",
+			keyType: typeHash,
+			setupMock: func(m *MockRedisClient) {
+				m.On("HGetAll", mock.Anything, "test-key").
+					Return(redis.NewMapStringStringResult(map[string]string{"test": `{"state":"ENABLED"}`}, nil))
+			},
+			method: methodHGetAll,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockRedisClient{}
+			tt.setupMock(mockClient)
+			rs := newMetricsTestSync(mockClient)
+			rs.Type = tt.keyType
+
+			_, err := rs.fetchData(context.Background())
+			require.NoError(t, err)
+
+			for _, method := range []string{methodJSON, methodGet, methodHGetAll} {
+				expected := 0.0
+				if method == tt.method {
+					expected = 1
+				}
+				assert.InDelta(t, expected, testutil.ToFloat64(rs.metrics.fetchSuccesses.WithLabelValues(method)), 0,
+					method)
+			}
+
+			recorder := httptest.NewRecorder()
+			rs.MetricsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+			assert.Contains(t, recorder.Body.String(),
+				fmt.Sprintf(`flagd_redis_sync_fetch_duration_seconds_count{method=%q} 1`, tt.method))
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// fetchDocuments fetches the documents of keys in a single round trip, an empty string for missing keys. JSON.MGET
+// reads them with the Redis JSON module unless it is unavailable, then MGET the keys it found no document for, such
+// as documents stored as strings.
+func (rs *Sync) fetchDocuments(ctx context.Context, keys []string) ([]string, error) {
+	path := rs.jsonPath()
+	documents := make([]string, len(keys))
+
+	remaining := make([]int, len(keys))
+	for i := range keys {
+		remaining[i] = i
+	}
+	if rs.useJSONModule(path) {
+		missing, err := rs.jsonMGet(ctx, keys, path, documents)
+		switch {
+		case err == nil:
+			remaining = missing
+		case isBatchUnsupported(err) && !isUnknownCommand(err):
+			// MGET would be refused as well
+			return nil, err
+		case !isRootPath(path):
+			return nil, fmt.Errorf("JSON path %s requires the Redis JSON module: %w", path, err)
+		default:
+			rs.Logger.Debug("Redis JSON.MGET failed, falling back to MGET", rs.logFields(zap.Error(err))...)
+		}
+	}
+	rs.recordJSONModuleUsed(len(remaining) < len(keys))
+
+	if len(remaining) > 0 {
+		if err := rs.mget(ctx, keys, remaining, documents); err != nil {
+			return nil, err
+		}
+	}
+	return documents, nil
+}
+
+// jsonMGet reads the documents of keys at path with JSON.MGET into documents, returning the indices of the keys it
+// found no document for
+func (rs *Sync) jsonMGet(ctx context.Context, keys []string, path string, documents []string) ([]int, error) {
+	var result *redis.JSONSliceCmd
+	_ = rs.withRetry(ctx, "JSON.MGET", func() error {
+		result = rs.client().JSONMGet(ctx, path, keys...)
+		return result.Err()
+	})
+	rs.recordJSONModule(result.Err())
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+
+	values := result.Val()
+	if len(values) != len(keys) {
+		return nil, fmt.Errorf("unexpected result of Redis JSON.MGET: %d values for %d keys", len(values), len(keys))
+	}
+
+	var missing []int
+	for i, value := range values {
+		// keys without a JSON document are nil
+		jsonString, _ := value.(string)
+		if jsonString == "" {
+			missing = append(missing, i)
+			continue
+		}
+
+		var err error
+		if documents[i], err = rs.decodeJSONDocument(keys[i], jsonString, path); err != nil {
+			return nil, fmt.Errorf("failed to fetch Redis key %s: %w", keys[i], err)
+		}
+	}
+	return missing, nil
+}
+
+// mget reads the string values of the keys at indices with MGET into documents, leaving missing keys empty
+func (rs *Sync) mget(ctx context.Context, keys []string, indices []int, documents []string) error {
+	names := make([]string, len(indices))
+	for j, i := range indices {
+		names[j] = keys[i]
+	}
+
+	var result *redis.SliceCmd
+	_ = rs.withRetry(ctx, "MGET", func() error {
+		result = rs.client().MGet(ctx, names...)
+		return result.Err()
+	})
+	if err := result.Err(); err != nil {
+		return fmt.Errorf("failed to get data from Redis: %w", err)
+	}
+
+	values := result.Val()
+	if len(values) != len(names) {
+		return fmt.Errorf("unexpected result of Redis MGET: %d values for %d keys", len(values), len(names))
+	}
+
+	for j, value := range values {
+		// missing keys are nil
+		data, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		var err error
+		if documents[indices[j]], err = rs.decodeGet(names[j], data); err != nil {
+			return fmt.Errorf("failed to fetch Redis key %s: %w", names[j], err)
+		}
+	}
+	return nil
+}
+
+// isBatchUnsupported reports whether err shows the server refuses to read several keys in one command, such as a
+// cluster whose keys span several slots or an allowlist without the command
+func isBatchUnsupported(err error) bool {
+	return isUnknownCommand(err) || errors.Is(err, ErrCommandNotAllowed) || strings.Contains(err.Error(), "CROSSSLOT")
+}
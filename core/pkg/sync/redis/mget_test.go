@@ -0,0 +1,120 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// jsonSliceCmd returns the result of JSON.MGET, nil values standing for keys without a JSON document
+func jsonSliceCmd(values ...interface{}) *redis.JSONSliceCmd {
+	cmd := &redis.JSONSliceCmd{}
+	cmd.SetVal(values)
+	return cmd
+}
+
+func TestRedisSync_fetchMergedSingleMGet(t *testing.T) {
+	keys := []string{"team-a", "team-b", "team-c"}
+
+	unavailable := &redis.JSONCmd{}
+	unavailable.SetErr(errors.New("ERR unknown command 'JSON.GET'"))
+	unavailableMGet := &redis.JSONSliceCmd{}
+	unavailableMGet.SetErr(errors.New("ERR unknown command 'JSON.MGET'"))
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONMGet", mock.Anything, rootPath, keys).Return(unavailableMGet).Once()
+	mockClient.On("MGet", mock.Anything, keys).Return(redis.NewSliceResult([]interface{}{
+		`{"flags":{"a":{"state":"ENABLED"}}}`,
+		nil,
+		`{"flags":{"c":{"state":"DISABLED"}}}`,
+	}, nil))
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    keys[0],
+		Keys:   keys,
+	}
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, `{"flags":{"a":{"state":"ENABLED"},"c":{"state":"DISABLED"}}}`, data)
+	assert.False(t, rs.Stats().JSONModule)
+
+	// once the module is known to be missing, a single MGET fetches all keys
+	data, err = rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, `{"flags":{"a":{"state":"ENABLED"},"c":{"state":"DISABLED"}}}`, data)
+	mockClient.AssertNumberOfCalls(t, "JSONMGet", 1)
+	mockClient.AssertNumberOfCalls(t, "MGet", 2)
+	mockClient.AssertNotCalled(t, "JSONGet", mock.Anything, mock.Anything, mock.Anything)
+	mockClient.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+}
+
+func TestRedisSync_fetchMergedJSONMGetThenMGet(t *testing.T) {
+	keys := []string{"team-a", "team-b", "team-c"}
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONMGet", mock.Anything, rootPath, keys).
+		Return(jsonSliceCmd(`{"flags":{"a":{"state":"ENABLED"}}}`, nil, nil))
+	// documents stored as strings are read with a single MGET
+	mockClient.On("MGet", mock.Anything, []string{"team-b", "team-c"}).
+		Return(redis.NewSliceResult([]interface{}{"flags:\n  b:\n    state: DISABLED\n", nil}, nil)).Once()
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    keys[0],
+		Keys:   keys,
+		Format: formatYAML,
+	}
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"flags":{"a":{"state":"ENABLED"},"b":{"state":"DISABLED"}}}`, data)
+	assert.True(t, rs.Stats().JSONModule)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_fetchMergedFallsBackToSequentialFetches(t *testing.T) {
+	keys := []string{"{team}-a", "team-b"}
+
+	crossSlot := fmt.Errorf("CROSSSLOT Keys in request don't hash to the same slot")
+	jsonCrossSlot := &redis.JSONSliceCmd{}
+	jsonCrossSlot.SetErr(crossSlot)
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONMGet", mock.Anything, rootPath, keys).Return(jsonCrossSlot)
+	mockClient.On("JSONGet", mock.Anything, "{team}-a", mock.Anything).
+		Return(jsonCmd(`{"flags":{"a":{"state":"ENABLED"}}}`))
+	mockClient.On("JSONGet", mock.Anything, "team-b", mock.Anything).
+		Return(jsonCmd(`{"flags":{"b":{"state":"DISABLED"}}}`))
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    keys[0],
+		Keys:   keys,
+	}
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, `{"flags":{"a":{"state":"ENABLED"},"b":{"state":"DISABLED"}}}`, data)
+	mockClient.AssertNotCalled(t, "MGet", mock.Anything, mock.Anything)
+	mockClient.AssertNumberOfCalls(t, "JSONGet", 2)
+}
+
+func TestIsBatchUnsupported(t *testing.T) {
+	assert.True(t, isBatchUnsupported(errors.New("ERR unknown command 'MGET'")))
+	assert.True(t, isBatchUnsupported(errors.New("CROSSSLOT Keys in request don't hash to the same slot")))
+	assert.True(t, isBatchUnsupported(fmt.Errorf("failed to get data from Redis: %w", ErrCommandNotAllowed)))
+	assert.False(t, isBatchUnsupported(errors.New("dial tcp: connection refused")))
+}
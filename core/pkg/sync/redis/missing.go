@@ -0,0 +1,69 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ErrKeyMissing is returned by a fetch when the key, after having had data, is missing for WarnOnMissingAfter
+var ErrKeyMissing = errors.New("key missing after having data")
+
+// checkMissingKey reports ErrKeyMissing once the synced key, which had data, is missing for WarnOnMissingAfter,
+// rather than treating it as an empty configuration. While the key has data, its TTL tells whether it expires, so
+// that a missing key is reported as expired, such as when its writer stopped refreshing it, rather than deleted.
+func (rs *Sync) checkMissingKey(ctx context.Context, data string) error {
+	if rs.WarnOnMissingAfter <= 0 {
+		return nil
+	}
+
+	if data != "" {
+		expires := rs.keyExpires(ctx)
+		rs.mu.Lock()
+		defer rs.mu.Unlock()
+		rs.missingSince = time.Time{}
+		rs.expiringKey = expires
+		return nil
+	}
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if rs.LastSHA == "" {
+		// the key never had data, which is no sign of a stopped writer
+		return nil
+	}
+	if rs.missingSince.IsZero() {
+		rs.missingSince = time.Now()
+	}
+	missing := time.Since(rs.missingSince)
+	if missing < rs.WarnOnMissingAfter {
+		return nil
+	}
+
+	reason := "deleted"
+	if rs.expiringKey {
+		reason = "expired"
+	}
+	return fmt.Errorf("%w: %s %s, missing for %s", ErrKeyMissing, rs.Key, reason, missing.Round(time.Second))
+}
+
+// keyExpires reports whether any synced key has a TTL. A failed TTL command counts as no expiry, as it only refines
+// the warning of a missing key.
+func (rs *Sync) keyExpires(ctx context.Context) bool {
+	for _, key := range rs.syncedKeys() {
+		ttl, err := rs.client().TTL(ctx, key).Result()
+		if err != nil {
+			rs.Logger.Debug("failed to read the TTL of Redis key",
+				rs.logFields(zap.String("ttlKey", key), zap.Error(err))...)
+			continue
+		}
+		// go-redis reports a key without expiry as -1 and a missing key as -2
+		if ttl > 0 {
+			return true
+		}
+	}
+	return false
+}
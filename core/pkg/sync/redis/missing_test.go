@@ -0,0 +1,165 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_WarnOnMissingAfter(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags&warn_on_missing_after=90s", log)
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, rs.WarnOnMissingAfter)
+
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags", log)
+	require.NoError(t, err)
+	assert.Zero(t, rs.WarnOnMissingAfter)
+
+	_, err = NewRedisSync("redis://localhost:6379/0?key=flags&warn_on_missing_after=soon", log)
+	assert.ErrorContains(t, err, "warn_on_missing_after")
+	_, err = NewRedisSync("redis://localhost:6379/0?key=flags&warn_on_missing_after=-1m", log)
+	assert.ErrorContains(t, err, "warn_on_missing_after")
+}
+
+func TestRedisSync_fetchDataKeyDisappeared(t *testing.T) {
+	document := `{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+
+	tests := []struct {
+		name     string
+		ttl      time.Duration
+		expected string
+	}{
+		{name: "expired", ttl: 30 * time.Second, expected: "key missing after having data: flags expired"},
+		// go-redis reports a key without expiry as -1
+		{name: "deleted", ttl: -1, expected: "key missing after having data: flags deleted"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockRedisClient{}
+			mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).Return(jsonCmd(document)).Once()
+			mockClient.On("TTL", mock.Anything, "flags").Return(redis.NewDurationResult(tt.ttl, nil)).Once()
+			missing := &redis.JSONCmd{}
+			missing.SetErr(redis.Nil)
+			mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).Return(missing)
+			mockClient.On("Get", mock.Anything, "flags").Return(redis.NewStringResult("", redis.Nil))
+
+			rs := &Sync{
+				Client:             mockClient,
+				Logger:             logger.NewLogger(zap.NewNop(), false),
+				Key:                "flags",
+				WarnOnMissingAfter: time.Minute,
+			}
+
+			data, err := rs.fetchData(context.Background())
+			require.NoError(t, err)
+			assert.JSONEq(t, document, data)
+
+			// within WarnOnMissingAfter, the missing key is an empty configuration
+			data, err = rs.fetchData(context.Background())
+			require.NoError(t, err)
+			assert.Empty(t, data)
+
+			// past it, fetches fail, degrading the readiness until the key is back
+			rs.missingSince = time.Now().Add(-time.Minute)
+			_, err = rs.fetchData(context.Background())
+			require.ErrorIs(t, err, ErrKeyMissing)
+			assert.ErrorContains(t, err, tt.expected)
+			assert.ErrorIs(t, rs.Stats().LastError, ErrKeyMissing)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRedisSync_fetchDataKeyBack(t *testing.T) {
+	document := `{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).Return(jsonCmd(document))
+	mockClient.On("TTL", mock.Anything, "flags").Return(redis.NewDurationResult(30*time.Second, nil))
+
+	rs := &Sync{
+		Client:             mockClient,
+		Logger:             logger.NewLogger(zap.NewNop(), false),
+		Key:                "flags",
+		WarnOnMissingAfter: time.Minute,
+		LastSHA:            "previous",
+		missingSince:       time.Now().Add(-time.Hour),
+	}
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.JSONEq(t, document, data)
+	assert.True(t, rs.missingSince.IsZero())
+	assert.True(t, rs.expiringKey)
+	assert.NoError(t, rs.Stats().LastError)
+}
+
+func TestRedisSync_fetchDataKeyNeverExisted(t *testing.T) {
+	missing := &redis.JSONCmd{}
+	missing.SetErr(redis.Nil)
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).Return(missing)
+	mockClient.On("Get", mock.Anything, "flags").Return(redis.NewStringResult("", redis.Nil))
+
+	rs := &Sync{
+		Client:             mockClient,
+		Logger:             logger.NewLogger(zap.NewNop(), false),
+		Key:                "flags",
+		WarnOnMissingAfter: time.Nanosecond,
+	}
+
+	for range 2 {
+		data, err := rs.fetchData(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, data)
+	}
+	// TTL is only read while the key has data
+	mockClient.AssertNotCalled(t, "TTL", mock.Anything, mock.Anything)
+}
+
+func TestRedisSync_pollKeyDisappeared(t *testing.T) {
+	missing := &redis.JSONCmd{}
+	missing.SetErr(redis.Nil)
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).Return(missing)
+	mockClient.On("Get", mock.Anything, "flags").Return(redis.NewStringResult("", redis.Nil))
+
+	errs := make(chan SyncError, 1)
+	rs := &Sync{
+		URI:                "redis://localhost:6379/0?key=flags",
+		Client:             mockClient,
+		Logger:             logger.NewLogger(zap.NewNop(), false),
+		Key:                "flags",
+		WarnOnMissingAfter: time.Minute,
+		Errors:             errs,
+		LastSHA:            "previous",
+		missingSince:       time.Now().Add(-time.Minute),
+		metrics:            newMetrics(),
+	}
+
+	dataSync := make(chan sync.DataSync, 1)
+	rs.poll(context.Background(), dataSync)
+
+	select {
+	case event := <-errs:
+		assert.ErrorIs(t, event.Err, ErrKeyMissing)
+	default:
+		t.Fatal("expected the missing key to be reported")
+	}
+	assert.Empty(t, dataSync)
+	// a missing key isn't a lost connection
+	assert.False(t, rs.disconnected)
+}
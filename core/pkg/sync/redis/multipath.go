@@ -0,0 +1,143 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// parsePaths validates the paths query parameter, the comma separated JSON paths of the sections of the
+// configuration read together with a single JSON.GET
+func parsePaths(query url.Values, keyType string, skipJSONModule bool) ([]string, error) {
+	value := query.Get("paths")
+	if value == "" {
+		return nil, nil
+	}
+
+	switch {
+	case query.Get("path") != "":
+		return nil, errors.New("query parameters 'path' and 'paths' are mutually exclusive")
+	case keyType != typeDocument:
+		return nil, fmt.Errorf("query parameter 'paths' can't be combined with type '%s'", keyType)
+	case skipJSONModule:
+		return nil, errors.New("query parameter 'paths' requires the Redis JSON module, disabled by 'json_module'")
+	}
+
+	var paths []string
+	fields := map[string]string{}
+	for _, path := range strings.Split(value, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+
+		field, err := pathField(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for query parameter 'paths': %w", err)
+		}
+		if previous, ok := fields[field]; ok {
+			return nil, fmt.Errorf("invalid value for query parameter 'paths': %s and %s both read '%s'",
+				previous, path, field)
+		}
+		fields[field] = path
+		paths = append(paths, path)
+	}
+	if len(paths) == 0 {
+		return nil, errors.New("invalid value for query parameter 'paths': no path")
+	}
+	return paths, nil
+}
+
+// pathField returns the top-level field of the configuration assembled from the value at path, the last member
+// name of the path. evaluators names the $evaluators of the flagd configuration, e.g. $.evaluators or
+// $['$evaluators'].
+func pathField(path string) (string, error) {
+	name := path
+	if strings.HasSuffix(path, "]") {
+		open := strings.LastIndex(path, "[")
+		if open < 0 {
+			return "", fmt.Errorf("unbalanced brackets in JSON path %s", path)
+		}
+		name = strings.Trim(path[open+1:len(path)-1], `'"`)
+	} else if dot := strings.LastIndex(path, "."); dot >= 0 {
+		name = path[dot+1:]
+	}
+
+	switch name {
+	case "", "$", "*":
+		return "", fmt.Errorf("JSON path %s doesn't end with a member name", path)
+	case "evaluators":
+		return "$evaluators", nil
+	}
+	return name, nil
+}
+
+// fetchPaths reads the values at Paths of the document key with a single JSON.GET and assembles them into a
+// configuration, each under the field named by its path. Paths matching nothing are left out, and a key without any
+// match returns an empty string like a missing key.
+func (rs *Sync) fetchPaths(ctx context.Context, key string) (string, error) {
+	var result *redis.JSONCmd
+	_ = rs.withRetry(ctx, "JSON.GET", func() error {
+		result = rs.client().JSONGet(ctx, key, rs.Paths...)
+		return result.Err()
+	})
+	rs.recordJSONModule(result.Err())
+	if err := result.Err(); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read JSON paths %s from Redis: %w", strings.Join(rs.Paths, ","), err)
+	}
+	rs.recordJSONModuleUsed(true)
+
+	reply, err := rs.transformValue(key, result.Val())
+	if err != nil {
+		return "", err
+	}
+	if reply == "" {
+		return "", nil
+	}
+
+	// A single path replies with its value, several paths with an object of the values by path
+	values := map[string]json.RawMessage{}
+	if len(rs.Paths) == 1 {
+		values[rs.Paths[0]] = json.RawMessage(reply)
+	} else if err := json.Unmarshal([]byte(reply), &values); err != nil {
+		return "", fmt.Errorf("unexpected reply of JSON.GET with several paths: %w", err)
+	}
+
+	document := map[string]json.RawMessage{}
+	for _, path := range rs.Paths {
+		value := string(values[path])
+		// JSONPath queries reply with an array of matches
+		if value != "" && strings.HasPrefix(path, "$") {
+			var err error
+			if value, err = firstJSONPathMatch(value); err != nil {
+				return "", err
+			}
+		}
+		if value == "" {
+			continue
+		}
+
+		field, err := pathField(path)
+		if err != nil {
+			return "", err
+		}
+		document[field] = json.RawMessage(value)
+	}
+	if len(document) == 0 {
+		return "", nil
+	}
+
+	data, err := json.Marshal(document)
+	if err != nil {
+		return "", fmt.Errorf("failed to assemble the configuration of JSON paths: %w", err)
+	}
+	return string(data), nil
+}
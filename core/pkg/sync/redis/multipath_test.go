@@ -0,0 +1,105 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_Paths(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags&paths=$.flags,%20$.evaluators", log)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"$.flags", "$.evaluators"}, rs.Paths)
+
+	for name, uri := range map[string]string{
+		"with path":        "redis://localhost:6379/0?key=flags&paths=$.flags&path=$.config",
+		"hash type":        "redis://localhost:6379/0?key=flags&paths=$.flags&type=hash",
+		"no JSON module":   "redis://localhost:6379/0?key=flags&paths=$.flags&json_module=false",
+		"no member name":   "redis://localhost:6379/0?key=flags&paths=$.flags,$.*",
+		"same field twice": "redis://localhost:6379/0?key=flags&paths=$.flags,$.config.flags",
+		"only separators":  "redis://localhost:6379/0?key=flags&paths=,,",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewRedisSync(uri, log)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestPathField(t *testing.T) {
+	for path, field := range map[string]string{
+		"$.flags":              "flags",
+		".config.flags":        "flags",
+		"flags":                "flags",
+		"$.evaluators":         "$evaluators",
+		"$['$evaluators']":     "$evaluators",
+		`$.config["metadata"]`: "metadata",
+	} {
+		got, err := pathField(path)
+		require.NoError(t, err, path)
+		assert.Equal(t, field, got, path)
+	}
+}
+
+func TestRedisSync_fetchDataAssemblesPaths(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).Return(jsonCmd(
+		`{"$.flags":[{"a":{"state":"ENABLED"}}],"$.evaluators":[{"local":{"in":["x"]}}]}`,
+	)).Once()
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "flags",
+		Paths:  []string{"$.flags", "$.evaluators"},
+	}
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"flags":{"a":{"state":"ENABLED"}},"$evaluators":{"local":{"in":["x"]}}}`, data)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_fetchDataPathsSkipsUnmatched(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).Return(jsonCmd(
+		`{"$.flags":[{"a":{"state":"ENABLED"}}],"$.evaluators":[]}`,
+	)).Once()
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "flags",
+		Paths:  []string{"$.flags", "$.evaluators"},
+	}
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"flags":{"a":{"state":"ENABLED"}}}`, data)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_fetchDataSinglePath(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).
+		Return(jsonCmd(`{"a":{"state":"ENABLED"}}`)).Once()
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "flags",
+		Paths:  []string{".config.flags"},
+	}
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"flags":{"a":{"state":"ENABLED"}}}`, data)
+	mockClient.AssertExpectations(t)
+}
@@ -0,0 +1,153 @@
+package redis
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// Option configures a Sync when it is created, overriding the settings of the URI
+type Option func(*Sync) error
+
+// WithKeys sets the keys holding the configuration, several keys being merged in order
+func WithKeys(keys ...string) Option {
+	return func(rs *Sync) error {
+		rs.Keys = slices.Clone(keys)
+		if len(keys) > 0 {
+			rs.Key = keys[0]
+		}
+		return nil
+	}
+}
+
+// WithInterval sets the polling interval in seconds
+func WithInterval(interval uint32) Option {
+	return func(rs *Sync) error {
+		rs.SetInterval(interval)
+		return nil
+	}
+}
+
+// WithLogger sets the logger of the provider
+func WithLogger(logger *logger.Logger) Option {
+	return func(rs *Sync) error {
+		rs.Logger = logger
+		return nil
+	}
+}
+
+// WithName sets the name identifying the provider instead of its URI, like the name query parameter
+func WithName(name string) Option {
+	return func(rs *Sync) error {
+		rs.Name = name
+		return nil
+	}
+}
+
+// WithTLSConfig connects with TLS configured by config, overriding the TLS settings of the URI. It has no effect on a
+// client passed to NewRedisSyncWithClient, which owns its connection settings.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(rs *Sync) error {
+		if config == nil {
+			return errors.New("Redis TLS config must not be nil")
+		}
+
+		rs.TLS = true
+		rs.setClientOptions(func(opts *redis.Options) {
+			opts.TLSConfig = config.Clone()
+		})
+		return nil
+	}
+}
+
+// WithRetries sets the number of times a failed connection is retried when the provider starts, like connect_retries
+func WithRetries(retries int) Option {
+	return func(rs *Sync) error {
+		if retries < 0 {
+			return fmt.Errorf("invalid number of Redis connection retries %d, expected a non-negative number", retries)
+		}
+
+		rs.ConnectRetries = retries
+		return nil
+	}
+}
+
+// WithHashAlgorithm sets the algorithm of the configuration hash, like hash
+func WithHashAlgorithm(algorithm string) Option {
+	return func(rs *Sync) error {
+		hashAlgorithm, err := parseHashAlgorithm(algorithm)
+		if err != nil {
+			return err
+		}
+
+		rs.HashAlgorithm = hashAlgorithm
+		return nil
+	}
+}
+
+// WithTransformFunc sets the function rewriting every raw value fetched before it is converted to JSON, e.g. to
+// unwrap an envelope or rename fields
+func WithTransformFunc(transform func([]byte) ([]byte, error)) Option {
+	return func(rs *Sync) error {
+		if transform == nil {
+			return errors.New("Redis transform func must not be nil")
+		}
+
+		rs.TransformFunc = transform
+		return nil
+	}
+}
+
+// applyOptions applies opts to rs in order
+func (rs *Sync) applyOptions(opts []Option) error {
+	for _, opt := range opts {
+		if err := opt(rs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewRedisSyncWithClient creates a new Redis sync provider reading through client, such as a client with a custom
+// dialer or instrumentation, instead of one created from a URI. The provider doesn't own the configuration of client,
+// so the connection settings of the URI query parameters don't apply; the other settings take their defaults unless
+// set by opts.
+func NewRedisSyncWithClient(client RedisClient, opts ...Option) (*Sync, error) {
+	if client == nil {
+		return nil, errors.New("Redis client must not be nil")
+	}
+
+	rs := &Sync{
+		Client:         client,
+		Cron:           newCron(""),
+		Logger:         logger.NewLogger(zap.NewNop(), false),
+		MaxKeys:        defaultMaxKeys,
+		Format:         formatJSON,
+		Type:           typeDocument,
+		HashAlgorithm:  hashSHA3256,
+		HashEncoding:   encodingBase64,
+		Interval:       30, // Default to 30 seconds
+		StartPolicy:    startPolicyFail,
+		DeletePolicy:   deletePolicyKeep,
+		ConnectRetries: defaultConnectRetries,
+		ConnectBackoff: defaultConnectBackoff,
+		FetchRetries:   defaultFetchRetries,
+		metrics:        newMetrics(),
+	}
+	if err := rs.applyOptions(opts); err != nil {
+		return nil, err
+	}
+
+	if rs.Logger == nil {
+		return nil, errors.New("Redis sync logger must not be nil")
+	}
+	if len(rs.Keys) == 0 || slices.Contains(rs.Keys, "") {
+		return nil, errors.New("Redis keys must be specified with WithKeys and not be empty")
+	}
+	return rs, nil
+}
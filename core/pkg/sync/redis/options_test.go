@@ -0,0 +1,130 @@
+package redis
+
+import (
+	"context"
+	"crypto/tls"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSyncWithClient(t *testing.T) {
+	flags := `{"flags":{"test":{"state":"ENABLED"}}}`
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).Return(jsonCmd(flags)).Once()
+
+	rs, err := NewRedisSyncWithClient(mockClient, WithKeys("flags"), WithInterval(5), WithLogger(log))
+	require.NoError(t, err)
+	assert.Same(t, log, rs.Logger)
+	assert.Equal(t, "flags", rs.Key)
+	assert.Equal(t, uint32(5), rs.Interval)
+	assert.Equal(t, hashSHA3256, rs.HashAlgorithm)
+	assert.Equal(t, defaultFetchRetries, rs.FetchRetries)
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, flags, data)
+	mockClient.AssertExpectations(t)
+}
+
+func TestNewRedisSyncWithClient_Invalid(t *testing.T) {
+	_, err := NewRedisSyncWithClient(nil, WithKeys("flags"))
+	require.ErrorContains(t, err, "client")
+
+	_, err = NewRedisSyncWithClient(&MockRedisClient{})
+	require.ErrorContains(t, err, "WithKeys")
+
+	_, err = NewRedisSyncWithClient(&MockRedisClient{}, WithKeys("flags", ""))
+	require.ErrorContains(t, err, "WithKeys")
+
+	_, err = NewRedisSyncWithClient(&MockRedisClient{}, WithKeys("flags"), WithLogger(nil))
+	require.ErrorContains(t, err, "logger")
+}
+
+func TestNewRedisSync_OptionsOverrideURI(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+	tlsConfig := &tls.Config{ServerName: "redis.internal", MinVersion: tls.VersionTLS13}
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags&hash=sha1&connect_retries=5", log,
+		WithInterval(5), WithRetries(0), WithHashAlgorithm(hashSHA256), WithTLSConfig(tlsConfig))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(5), rs.Interval)
+	assert.Equal(t, 0, rs.ConnectRetries)
+	assert.Equal(t, hashSHA256, rs.HashAlgorithm)
+	assert.True(t, rs.TLS)
+
+	options := rs.Client.(goRedisClient).Options()
+	require.NotNil(t, options.TLSConfig)
+	assert.Equal(t, "redis.internal", options.TLSConfig.ServerName)
+	// the client keeps a copy, so that later changes of the caller don't apply to it
+	assert.NotSame(t, tlsConfig, options.TLSConfig)
+
+	// settings without an option keep the ones of the URI
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags&hash=sha1&connect_retries=5", log, WithInterval(5))
+	require.NoError(t, err)
+	assert.Equal(t, hashSHA1, rs.HashAlgorithm)
+	assert.Equal(t, 5, rs.ConnectRetries)
+}
+
+func TestNewRedisSync_InvalidOptions(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	_, err := NewRedisSync("redis://localhost:6379/0?key=flags", log, WithHashAlgorithm("md5"))
+	require.ErrorContains(t, err, "md5")
+
+	_, err = NewRedisSync("redis://localhost:6379/0?key=flags", log, WithRetries(-1))
+	require.ErrorContains(t, err, "retries")
+
+	_, err = NewRedisSync("redis://localhost:6379/0?key=flags", log, WithTLSConfig(nil))
+	require.ErrorContains(t, err, "TLS config")
+}
+
+func TestNewRedisSyncWithConfig_ConnectionSettings(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+	retries := 0
+
+	rs, err := NewRedisSyncWithConfig(Config{
+		URI:          "redis://localhost:6379/0?key=flags&read_timeout=1s&dial_timeout=2s&connect_retries=5",
+		PasswordFile: "/run/secrets/redis",
+		TLSConfig:    &tls.Config{ServerName: "redis.internal", MinVersion: tls.VersionTLS13},
+		Timeouts:     Timeouts{Read: 4 * time.Second, Fetch: 10 * time.Second},
+		Retries:      &retries,
+	}, log)
+	require.NoError(t, err)
+	defer rs.Close()
+
+	assert.Equal(t, "/run/secrets/redis", rs.PasswordFile)
+	assert.True(t, rs.TLS)
+	assert.Equal(t, 0, rs.ConnectRetries)
+	assert.Equal(t, 4*time.Second, rs.ReadTimeout)
+	assert.Equal(t, 10*time.Second, rs.FetchTimeout)
+	// timeouts left zero keep the ones of the URI
+	assert.Equal(t, 2*time.Second, rs.DialTimeout)
+
+	options := rs.Client.(goRedisClient).Options()
+	require.NotNil(t, options.TLSConfig)
+	assert.Equal(t, "redis.internal", options.TLSConfig.ServerName)
+	assert.Equal(t, 4*time.Second, options.ReadTimeout)
+	assert.Equal(t, 2*time.Second, options.DialTimeout)
+}
+
+func TestNewRedisSyncWithConfig_InvalidConnectionSettings(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+	retries := -1
+
+	_, err := NewRedisSyncWithConfig(Config{URI: "redis://localhost:6379/0?key=flags", Retries: &retries}, log)
+	require.ErrorContains(t, err, "retries")
+
+	_, err = NewRedisSyncWithConfig(Config{
+		URI:      "redis://localhost:6379/0?key=flags",
+		Timeouts: Timeouts{Dial: -time.Second},
+	}, log)
+	require.ErrorContains(t, err, "timeout")
+}
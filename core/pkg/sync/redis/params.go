@@ -0,0 +1,42 @@
+package redis
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// queryParams are the query parameters of a Redis URI understood by NewRedisSync
+var queryParams = map[string]struct{}{
+	"key": {}, "pattern": {}, "max_keys": {}, "prefix": {}, "metadata_key": {}, "db": {}, "name": {},
+	"path": {}, "paths": {}, "type": {}, "json_module": {}, "format": {}, "encoding": {}, "compression": {},
+	"expand_env": {}, "expand_env_missing": {},
+	"hash": {}, "hash_encoding": {}, "flags": {}, "exclude": {},
+	"cron": {}, "watch": {}, "configure_notifications": {}, "channel": {}, "read-only": {}, "emit-on-reconnect": {},
+	"validate": {}, "schema": {}, "min_flags": {}, "warn_on_missing_after": {}, "require_key": {},
+	"start_policy": {}, "delete_policy": {}, "min_emit_interval": {},
+	"password_file": {}, "cache_file": {}, "control-key": {}, "audit": {}, "allowed-commands": {},
+	"dial_timeout": {}, "read_timeout": {}, "write_timeout": {}, "fetch_timeout": {}, "health_interval": {},
+	"pool_size": {}, "min_idle_conns": {}, "pool_timeout": {}, "conn_max_idle_time": {}, "conn_max_lifetime": {},
+	"protocol": {}, "sentinel_master": {}, "cluster": {}, "addr": {}, "replica_reads": {}, "replica_routing": {},
+	"connect_retries": {}, "connect_backoff": {},
+	"tls-server-name": {}, "tls-sni": {}, "tls_cert": {}, "tls_key": {}, "tls_ca": {}, "tls_insecure_skip_verify": {},
+}
+
+// validateQueryParams rejects the query parameters NewRedisSync doesn't understand, so a misspelled parameter fails
+// at startup instead of being silently ignored
+func validateQueryParams(query url.Values) error {
+	var unknown []string
+	for name := range query {
+		if _, ok := queryParams[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	slices.Sort(unknown)
+	return fmt.Errorf("unsupported query parameters: %s", strings.Join(unknown, ", "))
+}
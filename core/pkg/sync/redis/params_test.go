@@ -0,0 +1,58 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_UnknownQueryParams(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	tests := map[string]struct {
+		uri string
+		err string
+	}{
+		"misspelled key": {
+			uri: "redis://localhost:6379/0?keys=flags",
+			err: "unsupported query parameters: keys",
+		},
+		"unknown alongside key": {
+			uri: "redis://localhost:6379/0?key=flags&intervall=10",
+			err: "unsupported query parameters: intervall",
+		},
+		"several sorted": {
+			uri: "redis://localhost:6379/0?key=flags&whatch=true&fetch-timeout=5s",
+			err: "unsupported query parameters: fetch-timeout, whatch",
+		},
+		"unix socket": {
+			uri: "unix:///var/run/redis.sock?key=flags&database=1",
+			err: "unsupported query parameters: database",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewRedisSync(tt.uri, log)
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestNewRedisSync_KnownQueryParams(t *testing.T) {
+	uri := "redis://localhost:6379/0?key=flags&prefix=acme:&path=$.flags&type=document&hash=sha256" +
+		"&hash_encoding=hex&format=json&cron=*/5+*+*+*+*&watch=true&read_timeout=1s&fetch_timeout=2s" +
+		"&pool_size=4&protocol=3&connect_retries=2&tls-server-name=redis.internal&emit-on-reconnect=true"
+
+	_, err := NewRedisSync(uri, logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+}
+
+func TestValidateQueryParams(t *testing.T) {
+	for name := range queryParams {
+		assert.NoError(t, validateQueryParams(map[string][]string{name: {""}}), name)
+	}
+}
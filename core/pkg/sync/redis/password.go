@@ -0,0 +1,66 @@
+package redis
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// envReference matches ${ENV_VAR} references
+var envReference = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// expandUserinfo replaces ${ENV_VAR} references in the userinfo of a URI with the values of the environment
+// variables, escaping them so that the URI stays valid. The rest of the URI is left untouched.
+func expandUserinfo(uri string) string {
+	schemeEnd := strings.Index(uri, "://")
+	if schemeEnd < 0 {
+		return uri
+	}
+
+	authorityStart := schemeEnd + len("://")
+	rest := uri[authorityStart:]
+	authorityEnd := strings.IndexAny(rest, "/?#")
+	if authorityEnd < 0 {
+		authorityEnd = len(rest)
+	}
+
+	at := strings.LastIndex(rest[:authorityEnd], "@")
+	if at < 0 || !envReference.MatchString(rest[:at]) {
+		return uri
+	}
+
+	username, password, hasPassword := strings.Cut(rest[:at], ":")
+	user := url.User(expandEnv(username))
+	if hasPassword {
+		user = url.UserPassword(expandEnv(username), expandEnv(password))
+	}
+
+	return uri[:authorityStart] + user.String() + rest[at:]
+}
+
+// expandEnv replaces the ${ENV_VAR} references of value, unset variables expanding to an empty string
+func expandEnv(value string) string {
+	return envReference.ReplaceAllStringFunc(value, func(reference string) string {
+		return os.Getenv(envReference.FindStringSubmatch(reference)[1])
+	})
+}
+
+// loadPasswordFile reads the password from PasswordFile, taking precedence over the password of the URI
+func (rs *Sync) loadPasswordFile() error {
+	content, err := os.ReadFile(rs.PasswordFile)
+	if err != nil {
+		return fmt.Errorf("unable to read Redis password file: %w", err)
+	}
+
+	// secrets mounted from files commonly end with a newline
+	rs.Password = strings.TrimRight(string(content), "\r\n")
+	rs.setClientOptions(func(opts *redis.Options) {
+		opts.Password = rs.Password
+	})
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// defaultMaxKeys bounds the number of keys a pattern may match when max_keys isn't set
+	defaultMaxKeys = 100
+	// scanCount is the number of keys SCAN is hinted to examine per call
+	scanCount = 100
+)
+
+// parseMaxKeys validates the max_keys query parameter, the number of keys a pattern may match
+func parseMaxKeys(query url.Values) (int, error) {
+	value := query.Get("max_keys")
+	if value == "" {
+		return defaultMaxKeys, nil
+	}
+
+	maxKeys, err := strconv.Atoi(value)
+	if err != nil || maxKeys < 1 {
+		return 0, fmt.Errorf("invalid value for query parameter 'max_keys': %s", value)
+	}
+	return maxKeys, nil
+}
+
+// validatePattern checks that the pattern query parameter, if any, replaces the keys rather than being combined with
+// them or with a mode relying on fixed keys
+func validatePattern(query url.Values, keys []string, modes uriModes, keyType string) error {
+	if query.Get("pattern") == "" {
+		if query.Get("max_keys") != "" {
+			return errors.New("query parameter 'max_keys' requires 'pattern'")
+		}
+		return nil
+	}
+
+	switch {
+	case len(keys) > 0:
+		return errors.New("query parameters 'key' and 'pattern' are mutually exclusive")
+	case modes.watch:
+		return errors.New("query parameter 'pattern' can't be combined with 'watch'")
+	case keyType == typeStream:
+		return errors.New("query parameter 'pattern' can't be combined with type 'stream'")
+	}
+	return nil
+}
+
+// fetchPattern fetches every key matching Pattern and merges their documents in the order of their names
+func (rs *Sync) fetchPattern(ctx context.Context) (string, error) {
+	keys, err := rs.scanKeys(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(keys) == 0 {
+		return "", nil
+	}
+	return rs.fetchMerged(ctx, keys)
+}
+
+// scanKeys enumerates the keys matching Pattern with SCAN, sorted by name so that the merge order is stable. It
+// fails when more than MaxKeys keys match, rather than syncing part of the configuration.
+func (rs *Sync) scanKeys(ctx context.Context) ([]string, error) {
+	matched := map[string]bool{}
+	var cursor uint64
+	for {
+		var result *redis.ScanCmd
+		_ = rs.withRetry(ctx, "SCAN", func() error {
+			result = rs.client().Scan(ctx, cursor, rs.Pattern, scanCount)
+			return result.Err()
+		})
+		keys, next, err := result.Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan the Redis keys matching %s: %w", rs.Pattern, err)
+		}
+
+		// SCAN may return a key more than once
+		for _, key := range keys {
+			matched[key] = true
+		}
+		if len(matched) > rs.MaxKeys {
+			return nil, fmt.Errorf("more than %d Redis keys match %s, raise max_keys to sync them all",
+				rs.MaxKeys, rs.Pattern)
+		}
+
+		if next == 0 {
+			break
+		}
+		cursor = next
+	}
+
+	keys := make([]string, 0, len(matched))
+	for key := range matched {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
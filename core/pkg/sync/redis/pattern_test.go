@@ -0,0 +1,108 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_Pattern(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?pattern=flags:*&prefix=tenant:acme:", log)
+	require.NoError(t, err)
+	assert.Equal(t, "tenant:acme:flags:*", rs.Pattern)
+	assert.Equal(t, "tenant:acme:flags:*", rs.Key)
+	assert.Equal(t, defaultMaxKeys, rs.MaxKeys)
+
+	rs, err = NewRedisSync("redis://localhost:6379/0?pattern=flags:*&max_keys=10", log)
+	require.NoError(t, err)
+	assert.Equal(t, 10, rs.MaxKeys)
+
+	invalid := map[string]string{
+		"redis://localhost:6379/0":                               "'key' or 'pattern'",
+		"redis://localhost:6379/0?key=flags&pattern=flags:*":     "mutually exclusive",
+		"redis://localhost:6379/0?pattern=flags:*&watch=true":    "'watch'",
+		"redis://localhost:6379/0?pattern=flags:*&type=stream":   "'stream'",
+		"redis://localhost:6379/0?pattern=flags:*&max_keys=0":    "max_keys",
+		"redis://localhost:6379/0?pattern=flags:*&max_keys=many": "max_keys",
+		"redis://localhost:6379/0?key=flags&max_keys=10":         "requires 'pattern'",
+	}
+	for uri, expected := range invalid {
+		_, err := NewRedisSync(uri, log)
+		assert.ErrorContains(t, err, expected, uri)
+	}
+}
+
+func TestRedisSync_fetchDataMergesPatternKeys(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	// the keys are spread over two pages of the cursor, team-b being returned twice
+	mockClient.On("Scan", mock.Anything, uint64(0), "flags:*", int64(scanCount)).
+		Return(redis.NewScanCmdResult([]string{"flags:team-b"}, 7, nil)).Once()
+	mockClient.On("Scan", mock.Anything, uint64(7), "flags:*", int64(scanCount)).
+		Return(redis.NewScanCmdResult([]string{"flags:team-a", "flags:team-b"}, 0, nil)).Once()
+	mockClient.On("JSONMGet", mock.Anything, rootPath, []string{"flags:team-a", "flags:team-b"}).
+		Return(jsonSliceCmd(
+			`{"flags":{"a":{"state":"ENABLED"},"shared":{"state":"ENABLED"}}}`,
+			`{"flags":{"b":{"state":"DISABLED"},"shared":{"state":"DISABLED"}}}`,
+		)).Once()
+
+	rs := &Sync{
+		Client:  mockClient,
+		Logger:  logger.NewLogger(zap.NewNop(), false),
+		Key:     "flags:*",
+		Pattern: "flags:*",
+		MaxKeys: defaultMaxKeys,
+	}
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	// keys are merged in the order of their names
+	assert.Equal(t, `{"flags":{"a":{"state":"ENABLED"},"b":{"state":"DISABLED"},"shared":{"state":"DISABLED"}}}`, data)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_fetchDataPatternMatchingNothing(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("Scan", mock.Anything, uint64(0), "flags:*", int64(scanCount)).
+		Return(redis.NewScanCmdResult(nil, 0, nil)).Once()
+
+	rs := &Sync{
+		Client:  mockClient,
+		Logger:  logger.NewLogger(zap.NewNop(), false),
+		Key:     "flags:*",
+		Pattern: "flags:*",
+		MaxKeys: defaultMaxKeys,
+	}
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, data)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_fetchDataPatternBoundsKeys(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("Scan", mock.Anything, uint64(0), "flags:*", int64(scanCount)).
+		Return(redis.NewScanCmdResult([]string{"flags:a", "flags:b", "flags:c"}, 3, nil)).Once()
+
+	rs := &Sync{
+		Client:  mockClient,
+		Logger:  logger.NewLogger(zap.NewNop(), false),
+		Key:     "flags:*",
+		Pattern: "flags:*",
+		MaxKeys: 2,
+	}
+
+	_, err := rs.fetchData(context.Background())
+	require.ErrorContains(t, err, "more than 2 Redis keys match flags:*")
+	// the scan stops as soon as the bound is exceeded
+	mockClient.AssertNumberOfCalls(t, "Scan", 1)
+	mockClient.AssertNotCalled(t, "JSONMGet", mock.Anything, mock.Anything, mock.Anything)
+}
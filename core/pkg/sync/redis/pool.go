@@ -0,0 +1,65 @@
+package redis
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// uriPool holds the connection pool sizing and connection recycling set through query parameters
+type uriPool struct {
+	size            int
+	minIdleConns    int
+	timeout         time.Duration
+	connMaxIdleTime time.Duration
+	connMaxLifetime time.Duration
+}
+
+// parsePoolOptions parses the optional connection pool sizing and connection recycling from the query parameters,
+// zero keeping the go-redis defaults
+func parsePoolOptions(query url.Values) (uriPool, error) {
+	var parsed uriPool
+	params := []struct {
+		name  string
+		value *int
+	}{
+		{"pool_size", &parsed.size},
+		{"min_idle_conns", &parsed.minIdleConns},
+	}
+
+	for _, param := range params {
+		value := query.Get(param.name)
+		if value == "" {
+			continue
+		}
+		count, err := strconv.Atoi(value)
+		if err != nil || count < 0 {
+			return uriPool{}, fmt.Errorf("invalid value for query parameter '%s': %s", param.name, value)
+		}
+		*param.value = count
+	}
+
+	if parsed.size > 0 && parsed.minIdleConns > parsed.size {
+		return uriPool{}, fmt.Errorf("invalid value for query parameter 'min_idle_conns': %d exceeds pool_size %d",
+			parsed.minIdleConns, parsed.size)
+	}
+
+	durations := []struct {
+		name  string
+		value *time.Duration
+	}{
+		{"pool_timeout", &parsed.timeout},
+		{"conn_max_idle_time", &parsed.connMaxIdleTime},
+		{"conn_max_lifetime", &parsed.connMaxLifetime},
+	}
+	for _, param := range durations {
+		value, err := parseDurationParam(query, param.name)
+		if err != nil {
+			return uriPool{}, err
+		}
+		*param.value = value
+	}
+
+	return parsed, nil
+}
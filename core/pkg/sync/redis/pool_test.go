@@ -0,0 +1,118 @@
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_PoolOptions(t *testing.T) {
+	tests := []struct {
+		name            string
+		uri             string
+		expectError     bool
+		expectedSize    int
+		expectedMinIdle int
+		expectedTimeout time.Duration
+	}{
+		{
+			name: "defaults",
+			uri:  "redis://localhost:6379/0?key=flags",
+		},
+		{
+			name:            "sized pool",
+			uri:             "redis://localhost:6379/0?key=flags&pool_size=50&min_idle_conns=5&pool_timeout=2s",
+			expectedSize:    50,
+			expectedMinIdle: 5,
+			expectedTimeout: 2 * time.Second,
+		},
+		{
+			name:            "idle connections only",
+			uri:             "redis://localhost:6379/0?key=flags&min_idle_conns=2",
+			expectedMinIdle: 2,
+		},
+		{
+			name:        "non-numeric pool size",
+			uri:         "redis://localhost:6379/0?key=flags&pool_size=many",
+			expectError: true,
+		},
+		{
+			name:        "negative idle connections",
+			uri:         "redis://localhost:6379/0?key=flags&min_idle_conns=-1",
+			expectError: true,
+		},
+		{
+			name:        "more idle connections than the pool holds",
+			uri:         "redis://localhost:6379/0?key=flags&pool_size=2&min_idle_conns=5",
+			expectError: true,
+		},
+		{
+			name:        "invalid pool timeout",
+			uri:         "redis://localhost:6379/0?key=flags&pool_timeout=2",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, err := NewRedisSync(tt.uri, logger.NewLogger(zap.NewNop(), false))
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			defer rs.Close()
+
+			assert.Equal(t, tt.expectedSize, rs.PoolSize)
+			assert.Equal(t, tt.expectedMinIdle, rs.MinIdleConns)
+			assert.Equal(t, tt.expectedTimeout, rs.PoolTimeout)
+
+			// go-redis fills in its defaults for the unset options
+			options := rs.Client.(goRedisClient).Options()
+			assert.Equal(t, tt.expectedMinIdle, options.MinIdleConns)
+			if tt.expectedSize > 0 {
+				assert.Equal(t, tt.expectedSize, options.PoolSize)
+			} else {
+				assert.Positive(t, options.PoolSize)
+			}
+			if tt.expectedTimeout > 0 {
+				assert.Equal(t, tt.expectedTimeout, options.PoolTimeout)
+			}
+		})
+	}
+}
+
+func TestNewRedisSync_ConnectionRecycling(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags&conn_max_idle_time=4m&conn_max_lifetime=1h", log)
+	require.NoError(t, err)
+	defer rs.Close()
+
+	assert.Equal(t, 4*time.Minute, rs.ConnMaxIdleTime)
+	assert.Equal(t, time.Hour, rs.ConnMaxLifetime)
+	options := rs.Client.(goRedisClient).Options()
+	assert.Equal(t, 4*time.Minute, options.ConnMaxIdleTime)
+	assert.Equal(t, time.Hour, options.ConnMaxLifetime)
+
+	// unset, the go-redis defaults apply
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags", log)
+	require.NoError(t, err)
+	defer rs.Close()
+
+	assert.Zero(t, rs.ConnMaxIdleTime)
+	assert.Equal(t, 30*time.Minute, rs.Client.(goRedisClient).Options().ConnMaxIdleTime)
+	assert.Zero(t, rs.Client.(goRedisClient).Options().ConnMaxLifetime)
+
+	for _, uri := range []string{
+		"redis://localhost:6379/0?key=flags&conn_max_idle_time=4",
+		"redis://localhost:6379/0?key=flags&conn_max_lifetime=-1h",
+	} {
+		_, err := NewRedisSync(uri, log)
+		require.Error(t, err, uri)
+	}
+}
@@ -0,0 +1,37 @@
+package redis
+
+import (
+	"slices"
+	"strings"
+)
+
+// prefixKeys prepends prefix to every key, such as a tenant namespace shared by the keys of a deployment
+func prefixKeys(prefix string, keys []string) []string {
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = prefix + key
+	}
+	return prefixed
+}
+
+// SetKeyPrefix replaces the prefix of the synced keys, overriding the prefix query parameter. It must be called
+// before Init.
+func (rs *Sync) SetKeyPrefix(prefix string) {
+	keys := slices.Clone(rs.syncedKeys())
+	for i, key := range keys {
+		keys[i] = strings.TrimPrefix(key, rs.KeyPrefix)
+	}
+
+	rs.Keys = prefixKeys(prefix, keys)
+	rs.Key = rs.Keys[0]
+	if rs.Pattern != "" {
+		rs.Pattern = rs.Key
+	}
+	if rs.MetadataKey != "" {
+		rs.MetadataKey = prefix + strings.TrimPrefix(rs.MetadataKey, rs.KeyPrefix)
+	}
+	if rs.ControlKey != "" {
+		rs.ControlKey = prefix + strings.TrimPrefix(rs.ControlKey, rs.KeyPrefix)
+	}
+	rs.KeyPrefix = prefix
+}
@@ -0,0 +1,84 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_KeyPrefix(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags&prefix=tenant:acme:", log)
+	require.NoError(t, err)
+	assert.Equal(t, "tenant:acme:", rs.KeyPrefix)
+	assert.Equal(t, "tenant:acme:flags", rs.Key)
+	assert.Equal(t, []string{"__keyspace@0__:tenant:acme:flags"}, rs.keyspaceChannels())
+
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=team-a&key=team-b&prefix=tenant:acme:", log)
+	require.NoError(t, err)
+	assert.Equal(t, "tenant:acme:team-a", rs.Key)
+	assert.Equal(t, []string{"tenant:acme:team-a", "tenant:acme:team-b"}, rs.Keys)
+
+	// the prefix of the URI is replaced rather than prepended to
+	rs.SetKeyPrefix("tenant:globex:")
+	assert.Equal(t, "tenant:globex:", rs.KeyPrefix)
+	assert.Equal(t, "tenant:globex:team-a", rs.Key)
+	assert.Equal(t, []string{"tenant:globex:team-a", "tenant:globex:team-b"}, rs.Keys)
+
+	// the metadata and control keys are prefixed like the synced keys
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags&metadata_key=meta&control-key=control"+
+		"&prefix=tenant:acme:", log)
+	require.NoError(t, err)
+	assert.Equal(t, "tenant:acme:meta", rs.MetadataKey)
+	assert.Equal(t, "tenant:acme:control", rs.ControlKey)
+
+	rs.SetKeyPrefix("tenant:globex:")
+	assert.Equal(t, "tenant:globex:meta", rs.MetadataKey)
+	assert.Equal(t, "tenant:globex:control", rs.ControlKey)
+}
+
+func TestRedisSync_fetchDataUsesPrefixedKey(t *testing.T) {
+	document := `{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+
+	missing := &redis.JSONCmd{}
+	missing.SetErr(redis.Nil)
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "tenant:acme:flags", mock.Anything).Return(missing).Once()
+	mockClient.On("Get", mock.Anything, "tenant:acme:flags").Return(redis.NewStringResult(document, nil)).Once()
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "flags",
+	}
+	rs.SetKeyPrefix("tenant:acme:")
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.JSONEq(t, document, data)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_fetchMergedUsesPrefixedKeys(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONMGet", mock.Anything, rootPath, []string{"tenant:acme:team-a", "tenant:acme:team-b"}).
+		Return(jsonSliceCmd(`{"flags":{"a":{"state":"ENABLED"}}}`, `{"flags":{"b":{"state":"DISABLED"}}}`)).Once()
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=team-a&key=team-b&prefix=tenant:acme:",
+		logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+	rs.Client = mockClient
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, `{"flags":{"a":{"state":"ENABLED"},"b":{"state":"DISABLED"}}}`, data)
+	mockClient.AssertExpectations(t)
+}
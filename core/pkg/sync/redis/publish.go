@@ -0,0 +1,59 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrPublishUnsupported is returned by Publish when the configuration of the provider can't be written back as read
+var ErrPublishUnsupported = errors.New("configuration can't be written back to Redis")
+
+// Publish writes data, a JSON flag configuration, to the synced key, from which the next fetch reads it back. It is
+// written with JSON.SET at the path of the configuration, or with SET, keeping the TTL of the key, on servers without
+// the Redis JSON module. Only a single document key holding plain JSON can be written, and read-only providers refuse
+// to with ErrReadOnly.
+func (rs *Sync) Publish(ctx context.Context, data string) error {
+	if err := rs.publishable(); err != nil {
+		return err
+	}
+	if !json.Valid([]byte(data)) {
+		return errors.New("configuration to publish is not valid JSON")
+	}
+
+	key := rs.syncedKeys()[0]
+	path := rs.jsonPath()
+	if rs.useJSONModule(path) {
+		err := rs.client().JSONSet(ctx, key, path, data).Err()
+		rs.recordJSONModule(err)
+		if err == nil {
+			return nil
+		}
+		if !isUnknownCommand(err) || !isRootPath(path) {
+			return fmt.Errorf("failed to publish the configuration to Redis key %s: %w", key, err)
+		}
+	}
+
+	if err := rs.client().Set(ctx, key, data, redis.KeepTTL).Err(); err != nil {
+		return fmt.Errorf("failed to publish the configuration to Redis key %s: %w", key, err)
+	}
+	return nil
+}
+
+// publishable returns why the configuration of the provider can't be written back, nil when it can
+func (rs *Sync) publishable() error {
+	switch {
+	case rs.Pattern != "" || len(rs.syncedKeys()) != 1:
+		return fmt.Errorf("%w: it is merged from several keys", ErrPublishUnsupported)
+	case rs.Type != "" && rs.Type != typeDocument:
+		return fmt.Errorf("%w: keys of type %s", ErrPublishUnsupported, rs.Type)
+	case len(rs.Paths) > 0:
+		return fmt.Errorf("%w: it is assembled from several paths", ErrPublishUnsupported)
+	case rs.Format == formatYAML || rs.Encoding != "" || rs.Compression != "":
+		return fmt.Errorf("%w: values are encoded, compressed or YAML", ErrPublishUnsupported)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const publishedConfig = `{"flags":{"new-ui":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+
+func TestRedisSync_PublishWithJSONModule(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONSet", mock.Anything, "flags", "$.config", publishedConfig).
+		Return(redis.NewStatusResult("OK", nil)).Once()
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "flags",
+		Path:   "$.config",
+	}
+
+	require.NoError(t, rs.Publish(context.Background(), publishedConfig))
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_PublishFallsBackToSet(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONSet", mock.Anything, "flags", rootPath, publishedConfig).
+		Return(redis.NewStatusResult("", errors.New("ERR unknown command 'JSON.SET'"))).Once()
+	mockClient.On("Set", mock.Anything, "flags", publishedConfig, time.Duration(redis.KeepTTL)).
+		Return(redis.NewStatusResult("OK", nil)).Once()
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "flags",
+	}
+
+	require.NoError(t, rs.Publish(context.Background(), publishedConfig))
+	mockClient.AssertExpectations(t)
+
+	// the missing module is remembered, the next write going straight to SET
+	mockClient.On("Set", mock.Anything, "flags", publishedConfig, time.Duration(redis.KeepTTL)).
+		Return(redis.NewStatusResult("OK", nil)).Once()
+	require.NoError(t, rs.Publish(context.Background(), publishedConfig))
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_PublishFailure(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONSet", mock.Anything, "flags", rootPath, publishedConfig).
+		Return(redis.NewStatusResult("", errors.New("NOPERM this user has no permissions"))).Once()
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "flags",
+	}
+
+	require.ErrorContains(t, rs.Publish(context.Background(), publishedConfig), "NOPERM")
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_PublishRejected(t *testing.T) {
+	tests := map[string]struct {
+		rs   *Sync
+		data string
+	}{
+		"invalid JSON":  {rs: &Sync{Key: "flags"}, data: `{"flags":`},
+		"several keys":  {rs: &Sync{Key: "a", Keys: []string{"a", "b"}}, data: publishedConfig},
+		"pattern":       {rs: &Sync{Pattern: "flags:*"}, data: publishedConfig},
+		"hash":          {rs: &Sync{Key: "flags", Type: typeHash}, data: publishedConfig},
+		"several paths": {rs: &Sync{Key: "flags", Paths: []string{"$.flags", "$.evaluators"}}, data: publishedConfig},
+		"compressed":    {rs: &Sync{Key: "flags", Compression: compressionGzip}, data: publishedConfig},
+		"YAML":          {rs: &Sync{Key: "flags", Format: formatYAML}, data: publishedConfig},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// the strict mock fails on any command
+			tt.rs.Client = &MockRedisClient{}
+			tt.rs.Logger = logger.NewLogger(zap.NewNop(), false)
+			require.Error(t, tt.rs.Publish(context.Background(), tt.data))
+		})
+	}
+}
+
+func TestRedisSync_PublishReadOnly(t *testing.T) {
+	rs, err := NewRedisSync("redis://localhost:1/0?key=flags&read-only=true", logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+	defer rs.Close()
+
+	require.ErrorIs(t, rs.Publish(context.Background(), publishedConfig), ErrReadOnly)
+}
@@ -0,0 +1,91 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrReadOnly is returned when a command other than the reads of the provider is attempted in read-only mode
+var ErrReadOnly = errors.New("command refused in read-only mode")
+
+// readOnlyCommands lists the commands the provider issues to read the configuration, the only ones reaching the
+// server in read-only mode along with the connection handshake. Any other command, such as a write the list doesn't
+// know about, is refused.
+var readOnlyCommands = map[string]bool{
+	"get":          true,
+	"mget":         true,
+	"json.get":     true,
+	"json.mget":    true,
+	"hgetall":      true,
+	"lindex":       true,
+	"scan":         true,
+	"xread":        true,
+	"xrevrange":    true,
+	"ttl":          true,
+	"info":         true,
+	"module list":  true,
+	"ping":         true,
+	"subscribe":    true,
+	"psubscribe":   true,
+	"unsubscribe":  true,
+	"punsubscribe": true,
+	"config get":   true,
+}
+
+// commandName returns the lower-cased name of a command, including the subcommand of container commands
+func commandName(cmd redis.Cmder) string {
+	name := cmd.Name()
+	switch name {
+	case "config", "module", "client", "cluster", "command":
+		if args := cmd.Args(); len(args) > 1 {
+			if sub, ok := args[1].(string); ok {
+				return name + " " + strings.ToLower(sub)
+			}
+		}
+	}
+	return name
+}
+
+// readOnlyHook is a go-redis hook refusing every command but the reads of the provider before it is sent to the
+// server
+type readOnlyHook struct{}
+
+func (readOnlyHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (readOnlyHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if err := checkReadOnly(cmd); err != nil {
+			return err
+		}
+		return next(ctx, cmd)
+	}
+}
+
+func (readOnlyHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		for _, cmd := range cmds {
+			if err := checkReadOnly(cmd); err != nil {
+				return err
+			}
+		}
+		return next(ctx, cmds)
+	}
+}
+
+// checkReadOnly fails the command unless it is a read of the provider or part of the connection handshake
+func checkReadOnly(cmd redis.Cmder) error {
+	name := commandName(cmd)
+	if readOnlyCommands[name] || handshakeCommands[name] {
+		return nil
+	}
+
+	err := fmt.Errorf("%w: %s", ErrReadOnly, name)
+	cmd.SetErr(err)
+	return err
+}
@@ -0,0 +1,83 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestReadOnlyMode_RefusesWrites(t *testing.T) {
+	ctx := context.Background()
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	// nothing listens on port 1, so commands reaching the network fail with a connection error
+	rs, err := NewRedisSync("redis://127.0.0.1:1?key=flags&read-only=true", log)
+	require.NoError(t, err)
+	assert.True(t, rs.ReadOnly)
+
+	client := rs.Client.(goRedisClient)
+	defer client.Close()
+
+	assert.ErrorIs(t, client.Set(ctx, "flags", "{}", 0).Err(), ErrReadOnly)
+	assert.ErrorIs(t, client.JSONSet(ctx, "flags", "$", "{}").Err(), ErrReadOnly)
+	assert.ErrorIs(t, client.ConfigSet(ctx, notifyKeyspaceEvents, "KEA").Err(), ErrReadOnly)
+
+	// writes beyond the common ones are refused too, any command but the reads of the provider being refused
+	assert.ErrorIs(t, client.HMSet(ctx, "flags", "a", "{}").Err(), ErrReadOnly)
+	assert.ErrorIs(t, client.Incr(ctx, "flags").Err(), ErrReadOnly)
+	assert.ErrorIs(t, client.LPop(ctx, "flags").Err(), ErrReadOnly)
+	assert.ErrorIs(t, client.SAdd(ctx, "flags", "a").Err(), ErrReadOnly)
+	assert.ErrorIs(t, client.PExpireAt(ctx, "flags", time.Now()).Err(), ErrReadOnly)
+	assert.ErrorIs(t, client.JSONArrAppend(ctx, "flags", "$.a", 1).Err(), ErrReadOnly)
+	assert.ErrorIs(t, client.ScriptLoad(ctx, "return 1").Err(), ErrReadOnly)
+	assert.ErrorIs(t, client.Do(ctx, "FUNCTION", "LOAD", "#!lua name=x").Err(), ErrReadOnly)
+
+	_, err = client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Get(ctx, "flags")
+		pipe.Del(ctx, "flags")
+		return nil
+	})
+	assert.ErrorIs(t, err, ErrReadOnly)
+
+	// reads are let through
+	for _, err := range []error{
+		client.Get(ctx, "flags").Err(),
+		client.LIndex(ctx, "flags", 0).Err(),
+		client.TTL(ctx, "flags").Err(),
+		client.XRevRangeN(ctx, "flags", "+", "-", 1).Err(),
+		client.ConfigGet(ctx, notifyKeyspaceEvents).Err(),
+	} {
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrReadOnly)
+	}
+}
+
+func TestReadOnlyMode_Disabled(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://127.0.0.1:1?key=flags", log)
+	require.NoError(t, err)
+	assert.False(t, rs.ReadOnly)
+
+	client := rs.Client.(goRedisClient)
+	defer client.Close()
+
+	err = client.Set(context.Background(), "flags", "{}", 0).Err()
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrReadOnly)
+}
+
+func TestCommandName(t *testing.T) {
+	ctx := context.Background()
+
+	assert.Equal(t, "get", commandName(redis.NewStringCmd(ctx, "GET", "flags")))
+	assert.Equal(t, "json.set", commandName(redis.NewStatusCmd(ctx, "JSON.SET", "flags", "$", "{}")))
+	assert.Equal(t, "config set", commandName(redis.NewStatusCmd(ctx, "config", "SET", "x", "y")))
+	assert.Equal(t, "config get", commandName(redis.NewMapStringStringCmd(ctx, "config", "get", "x")))
+}
@@ -0,0 +1,66 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestRedisSync_PollEmitsAfterReconnect(t *testing.T) {
+	flagData := `{"flags":{"test":{"state":"ENABLED"}}}`
+
+	tests := []struct {
+		name            string
+		emitOnReconnect bool
+		expectedEmits   int
+	}{
+		{
+			name:            "unchanged configuration is emitted after reconnect",
+			emitOnReconnect: true,
+			expectedEmits:   2,
+		},
+		{
+			name:          "unchanged configuration is skipped by default",
+			expectedEmits: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unreachableJSON := &redis.JSONCmd{}
+			unreachableJSON.SetErr(errors.New("connection refused"))
+			unreachableGet := redis.NewStringCmd(context.Background())
+			unreachableGet.SetErr(errors.New("connection refused"))
+
+			mockClient := &MockRedisClient{}
+			mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(flagData)).Once()
+			mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(unreachableJSON).Once()
+			mockClient.On("Get", mock.Anything, "test-key").Return(unreachableGet).Once()
+			mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(flagData))
+
+			rs := &Sync{
+				URI:             "redis://localhost:6379/0?key=test-key",
+				Client:          mockClient,
+				Logger:          logger.NewLogger(zap.NewNop(), false),
+				Key:             "test-key",
+				EmitOnReconnect: tt.emitOnReconnect,
+			}
+			dataSync := make(chan sync.DataSync, 4)
+
+			rs.poll(context.Background(), dataSync) // initial configuration
+			rs.poll(context.Background(), dataSync) // Redis unreachable
+			rs.poll(context.Background(), dataSync) // reconnected, same SHA
+			rs.poll(context.Background(), dataSync) // connected, same SHA
+
+			assert.Len(t, dataSync, tt.expectedEmits)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
@@ -3,19 +3,40 @@ package redis
 import (
 	"context"
 	"crypto/tls"
-	"encoding/base64"
 	"errors"
 	"fmt"
+	"net"
 	"net/url"
 	"strconv"
 	"strings"
+	msync "sync"
+	"time"
 
 	"github.com/open-feature/flagd/core/pkg/logger"
 	"github.com/open-feature/flagd/core/pkg/sync"
 	"github.com/open-feature/flagd/core/pkg/utils"
 	"github.com/redis/go-redis/v9"
-	"github.com/robfig/cron"
-	"golang.org/x/crypto/sha3"
+	"go.uber.org/zap"
+)
+
+const (
+	// redactedPassword replaces passwords when URIs are logged
+	redactedPassword = "***"
+	// minInterval is the shortest polling interval in seconds
+	minInterval = 1
+)
+
+// ErrKeyNotFound is returned by Sync when require_key is set and the key is missing or empty at the initial fetch
+var ErrKeyNotFound = errors.New("key not found or empty")
+
+// Errors wrapped by NewRedisSync when the URI can't be used, for callers to tell them apart with errors.Is
+var (
+	// ErrInvalidURI is wrapped when the URI doesn't parse or its database or socket path is invalid
+	ErrInvalidURI = errors.New("invalid Redis URI")
+	// ErrUnsupportedScheme is wrapped when the scheme of the URI is none of redis, rediss or unix
+	ErrUnsupportedScheme = errors.New("unsupported Redis URI scheme")
+	// ErrMissingKey is wrapped when the URI has neither a key nor a pattern query parameter
+	ErrMissingKey = errors.New("Redis key must be specified in query parameter 'key' or 'pattern'")
 )
 
 // Sync implements the ISync interface for Redis JSON documents
@@ -26,21 +47,237 @@ type Sync struct {
 	Logger   *logger.Logger
 	Key      string
 	Database int
+	// Name identifies the provider instead of its URI as the source of the emitted configurations, in logs and in
+	// metrics, keeping credentials out of them
+	Name     string
+	Username string
 	Password string
-	TLS      bool
-	Interval uint32
+	// PasswordFile is read at Init, its content taking precedence over Password
+	PasswordFile string
+	// CacheFile stores the last fetched configuration, used when Redis can't be reached at startup
+	CacheFile string
+	// Encoding is the encoding of values read with GET, decoded before they are decompressed, "base64" or empty for
+	// none
+	Encoding string
+	// Compression is the compression of values read with GET, "gzip" or empty for none
+	Compression string
+	// Format is the format of values read with GET, "json" or "yaml"
+	Format string
+	// ExpandEnv expands the ${VAR} references of the fetched values with the process environment before they are
+	// converted to JSON, ExpandEnvMissing being "keep" to leave undefined variables as is or "error" to fail the fetch
+	ExpandEnv        bool
+	ExpandEnvMissing string
+	// TransformFunc rewrites every raw value fetched, such as unwrapping an envelope, before its environment
+	// variables are expanded and it is converted to JSON. Values are used as is when nil.
+	TransformFunc func([]byte) ([]byte, error)
+	// Type is the type of the Redis keys, "hash" assembling the configuration from one field per flag, "stream"
+	// reading it from the flags field of the latest entry, blocking on new ones instead of polling, and "list" reading
+	// it from the head element
+	Type string
+	// HashAlgorithm and HashEncoding select the hash of the configuration used for change detection and reported
+	// as LastSHA, SHA3-256 in URL-safe base64 by default
+	HashAlgorithm string
+	HashEncoding  string
+	TLS           bool
+	Interval      uint32
+	// CronSpec is a standard cron expression polling instead of Interval, e.g. "*/5 9-17 * * 1-5"
+	CronSpec string
 	LastSHA  string
-	ready    bool
+	// Path is the JSON path of the configuration within the document, read with the Redis JSON module
+	Path string
+	// Paths are the JSON paths of the sections of the configuration, read together with a single JSON.GET and
+	// assembled into a document instead of reading Path
+	Paths []string
+	// SkipJSONModule reads documents with GET only, saving the JSON.GET attempt on servers without the Redis JSON
+	// module
+	SkipJSONModule bool
+	// Keys lists every key of the configuration when several are merged, Key being the first of them
+	Keys []string
+	// KeyPrefix is prepended to the keys of the URI, Key and Keys holding the prefixed keys
+	KeyPrefix string
+	// Pattern discovers the keys of the configuration with SCAN at every fetch instead of fixed keys, their
+	// documents being merged in the order of their names. Key holds the pattern as well.
+	Pattern string
+	// MaxKeys is the most keys Pattern may match, fetches failing beyond it
+	MaxKeys int
+	// Flags lists the flag keys the configuration is filtered down to before it is hashed and emitted, all flags
+	// being kept when empty
+	Flags []string
+	// Exclude lists the flag keys dropped from the configuration after Flags is applied
+	Exclude []string
+	// MetadataKey is the key of an optional document whose $evaluators and metadata are merged into the
+	// configuration, the flags coming from Key
+	MetadataKey string
+	// WatchMode subscribes to keyspace notifications of the key instead of polling
+	WatchMode bool
+	// ConfigureNotifications enables keyspace notifications on the server at Init in watch mode, which requires the
+	// privilege to run CONFIG SET
+	ConfigureNotifications bool
+	// Channel optionally names a pub/sub channel whose messages trigger a fetch in addition to polling. It is ignored
+	// when keyspace notifications or a stream already push changes.
+	Channel string
+	// ReadOnly refuses any command but the reads of the provider, guaranteeing the provider never writes to the server
+	ReadOnly bool
+	// ControlKey optionally names a key holding {"interval": N, "paused": bool} to adjust polling at runtime
+	ControlKey string
+	// AuditCommands logs the name of every command issued
+	AuditCommands bool
+	// AllowedCommands refuses any command outside the list, apart from the connection handshake. Empty allows all.
+	AllowedCommands []string
+	// Validate rejects fetched documents that don't parse as a flag configuration
+	Validate bool
+	// SchemaValidation is "strict" when configurations that don't conform to the flagd flag schema must be rejected
+	SchemaValidation string
+	// MinFlags is the fewest flags a configuration may define, guarding against truncated data. Like the schema, it
+	// is enforced by the consumer of the configuration, such as the standalone service. Zero disables the guard.
+	MinFlags int
+	// DialTimeout, ReadTimeout and WriteTimeout bound the Redis connection, zero keeps the go-redis defaults
+	DialTimeout  time.Duration
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	// FetchTimeout bounds each fetch of the configuration as a whole, retries included, ReadTimeout bounding it when
+	// zero
+	FetchTimeout time.Duration
+	// Protocol is the RESP protocol version, 2 or 3, zero keeping the go-redis default
+	Protocol int
+	// PoolSize, MinIdleConns and PoolTimeout size the connection pool, zero keeps the go-redis defaults
+	PoolSize     int
+	MinIdleConns int
+	PoolTimeout  time.Duration
+	// ConnMaxIdleTime and ConnMaxLifetime recycle pooled connections that were idle or open that long, before a
+	// NAT or firewall drops them silently. Zero keeps the go-redis defaults, 30 minutes idle and no lifetime limit.
+	ConnMaxIdleTime time.Duration
+	ConnMaxLifetime time.Duration
+	// ConnectRetries is the number of times a failed connection is retried by Init, ConnectBackoff the delay before
+	// the first retry, doubling after each one
+	ConnectRetries int
+	ConnectBackoff time.Duration
+	// FetchRetries is the number of times a command fetching the configuration is retried after a transient error
+	FetchRetries int
+	// StartPolicy is what a failed initial fetch does, "fail" failing Sync and "retry" starting not ready and
+	// polling until a fetch succeeds
+	StartPolicy string
+	// RequireKey fails Sync with ErrKeyNotFound when the key is missing or empty at the initial fetch, rather than
+	// starting with an empty configuration
+	RequireKey bool
+	// WarnOnMissingAfter fails fetches with ErrKeyMissing once the key, after having had data, is missing for this
+	// long, such as when its writer stopped refreshing the TTL used as a heartbeat. Zero treats a missing key as an
+	// empty configuration.
+	WarnOnMissingAfter time.Duration
+	// DeletePolicy is what the deletion of a key that had data does, "keep" keeping the last configuration in effect
+	// and "clear" emitting an empty configuration
+	DeletePolicy string
+	// HealthInterval is the interval Redis is pinged at while syncing, a failed ping rebuilding the client and marking
+	// the provider not ready until a ping succeeds again. Zero disables the health check.
+	HealthInterval time.Duration
+	// MinEmitInterval is the shortest time between two emits of changed configurations, the changes in between being
+	// coalesced into an emit of the latest one. Zero emits every change.
+	MinEmitInterval time.Duration
+	// EmitOnReconnect emits the configuration after a failed fetch is followed by a successful one, even if unchanged
+	EmitOnReconnect bool
+	// TLSServerName is the name the server certificate is verified against, defaults to the host
+	TLSServerName string
+	// TLSSNI is the server name sent in the ClientHello, defaults to TLSServerName
+	TLSSNI string
+	// TLSCertFile and TLSKeyFile hold the client certificate for mutual TLS, TLSCAFile the root CA of the server
+	TLSCertFile string
+	TLSKeyFile  string
+	TLSCAFile   string
+	// TLSInsecureSkipVerify disables verification of the server certificate, only meant for development servers
+	TLSInsecureSkipVerify bool
+	// Errors optionally receives the failed fetches of the polling loop. Events are dropped while it is full.
+	Errors chan<- SyncError
+
+	metrics *metrics
+	// newClient builds a client replacing Client after a failed health check, nil when it can't be rebuilt
+	newClient func() RedisClient
+	// clientOptions are the options newClient builds the client of the URI topology with, nil for a client passed to
+	// NewRedisSyncWithClient
+	clientOptions *redis.Options
+	topology      topology
+
+	// mu guards LastSHA, Interval and the state below, written by the polling goroutine and read concurrently
+	mu           msync.RWMutex
+	ready        bool
+	disconnected bool
+	// serverInfo describes the server found by Init
+	serverInfo ServerInfo
+	// unhealthy is whether the last health check failed
+	unhealthy    bool
+	lastSyncTime time.Time
+	lastError    error
+	fetchCount   uint64
+	syncLag      time.Duration
+	syncLagKnown bool
+	// controlInterval is the polling interval currently applied by the control key, zero when none is
+	controlInterval uint32
+	// pollingScheduled is set once polling is registered on the interval, SetInterval rescheduling it from then on
+	pollingScheduled bool
+	// jsonModuleMissing is the time JSON.GET last failed as an unknown command, zero while the module is available
+	jsonModuleMissing time.Time
+	// jsonModuleUsed is whether the last document was read with JSON.GET rather than GET
+	jsonModuleUsed bool
+	// missingSince is the time the key was first found missing after having had data, expiringKey whether it had
+	// a TTL when it was last found
+	missingSince time.Time
+	expiringKey  bool
+
+	// pollMu serializes polls triggered concurrently, such as by the schedule and an invalidation message
+	pollMu msync.Mutex
+	// streamIDs holds the ID of the last entry read from each stream key
+	streamIDs map[string]string
+
+	// emitMu guards the state below, lastEmit being the time of the last emit and pendingData the latest
+	// configuration coalesced by MinEmitInterval, emitted by pendingEmit
+	emitMu      msync.Mutex
+	lastEmit    time.Time
+	pendingData string
+	pendingEmit *time.Timer
+
+	// closeOnce makes Close idempotent, closeErr being the result of closing the client
+	closeOnce msync.Once
+	closeErr  error
 }
 
 // RedisClient defines the interface for Redis operations
 type RedisClient interface {
 	JSONGet(ctx context.Context, key string, path ...string) *redis.JSONCmd
 	Get(ctx context.Context, key string) *redis.StringCmd
+	HGetAll(ctx context.Context, key string) *redis.MapStringStringCmd
 	Ping(ctx context.Context) *redis.StatusCmd
+	ConfigGet(ctx context.Context, parameter string) *redis.MapStringStringCmd
+	ConfigSet(ctx context.Context, parameter, value string) *redis.StatusCmd
+	Subscribe(ctx context.Context, channels ...string) PubSub
+	XRead(ctx context.Context, a *redis.XReadArgs) *redis.XStreamSliceCmd
+	XRevRangeN(ctx context.Context, stream, start, stop string, count int64) *redis.XMessageSliceCmd
+	LIndex(ctx context.Context, key string, index int64) *redis.StringCmd
+	Scan(ctx context.Context, cursor uint64, match string, count int64) *redis.ScanCmd
+	MGet(ctx context.Context, keys ...string) *redis.SliceCmd
+	JSONMGet(ctx context.Context, path string, keys ...string) *redis.JSONSliceCmd
+	TTL(ctx context.Context, key string) *redis.DurationCmd
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd
+	JSONSet(ctx context.Context, key, path string, value interface{}) *redis.StatusCmd
+	Info(ctx context.Context, sections ...string) *redis.StringCmd
+	ModuleList(ctx context.Context) *redis.SliceCmd
+	Close() error
+}
+
+// PubSub defines the interface for a Redis pub/sub subscription
+type PubSub interface {
+	Receive(ctx context.Context) (interface{}, error)
+	Channel(opts ...redis.ChannelOption) <-chan *redis.Message
 	Close() error
 }
 
+// goRedisClient adapts a go-redis client to the RedisClient interface
+type goRedisClient struct {
+	*redis.Client
+}
+
+func (c goRedisClient) Subscribe(ctx context.Context, channels ...string) PubSub {
+	return c.Client.Subscribe(ctx, channels...)
+}
+
 // Cron defines the interface for cron operations
 type Cron interface {
 	AddFunc(spec string, cmd func()) error
@@ -48,202 +285,1008 @@ type Cron interface {
 	Stop()
 }
 
-// NewRedisSync creates a new Redis sync provider
-func NewRedisSync(uri string, logger *logger.Logger) (*Sync, error) {
-	parsedURI, err := url.Parse(uri)
+// Config configures a Redis sync provider created with NewRedisSyncWithConfig
+type Config struct {
+	// URI is the Redis URI, e.g. redis://localhost:6379/0?key=flags
+	URI string
+	// Username and Password take precedence over the credentials of the URI, keeping them out of it
+	Username string
+	Password string
+	// PasswordFile takes precedence over the password_file of the URI, its content over the passwords
+	PasswordFile string
+	// TLSConfig connects with TLS configured by it, whatever the scheme and TLS settings of the URI
+	TLSConfig *tls.Config
+	// Timeouts take precedence over the timeouts of the URI, zero durations keeping them
+	Timeouts Timeouts
+	// Retries takes precedence over the connect_retries of the URI when set
+	Retries *int
+}
+
+// Timeouts bound the Redis connection and each fetch, like the dial_timeout, read_timeout, write_timeout and
+// fetch_timeout query parameters
+type Timeouts struct {
+	Dial  time.Duration
+	Read  time.Duration
+	Write time.Duration
+	Fetch time.Duration
+}
+
+// validate rejects the settings of cfg that can't apply to any URI
+func (cfg Config) validate() error {
+	if cfg.Retries != nil && *cfg.Retries < 0 {
+		return fmt.Errorf("invalid number of Redis connection retries %d, expected a non-negative number", *cfg.Retries)
+	}
+	for _, timeout := range []time.Duration{cfg.Timeouts.Dial, cfg.Timeouts.Read, cfg.Timeouts.Write, cfg.Timeouts.Fetch} {
+		if timeout < 0 {
+			return fmt.Errorf("invalid Redis timeout %s, must not be negative", timeout)
+		}
+	}
+	return nil
+}
+
+// NewRedisSync creates a new Redis sync provider from uri, opts overriding the settings of its query parameters
+func NewRedisSync(uri string, logger *logger.Logger, opts ...Option) (*Sync, error) {
+	return NewRedisSyncWithConfig(Config{URI: uri}, logger, opts...)
+}
+
+// NewRedisSyncWithConfig creates a new Redis sync provider from the URI of cfg, overriding its credentials, TLS,
+// timeouts and retries with the ones of cfg and its other settings with options
+func NewRedisSyncWithConfig(cfg Config, logger *logger.Logger, options ...Option) (*Sync, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	uri := cfg.URI
+	parsedURI, err := url.Parse(expandUserinfo(uri))
 	if err != nil {
-		return nil, fmt.Errorf("invalid Redis URI: %w", err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidURI, err)
 	}
 
-	if parsedURI.Scheme != "redis" && parsedURI.Scheme != "rediss" {
-		return nil, fmt.Errorf("unsupported scheme: %s, expected redis or rediss", parsedURI.Scheme)
+	switch parsedURI.Scheme {
+	case "redis", "rediss", "unix":
+	case "":
+		return nil, fmt.Errorf("%w: missing scheme, expected redis, rediss or unix", ErrInvalidURI)
+	default:
+		return nil, fmt.Errorf("%w: %s, expected redis, rediss or unix", ErrUnsupportedScheme, parsedURI.Scheme)
+	}
+	if err := validateQueryParams(parsedURI.Query()); err != nil {
+		return nil, err
 	}
 
-	// Extract connection parameters
-	host := parsedURI.Host
-	if host == "" {
-		host = "localhost:6379"
+	// Extract connection parameters, a unix URI holding the socket path and the database in the db parameter
+	network := "tcp"
+	hostname, address := redisAddress(parsedURI)
+	databasePath := parsedURI.Path
+	if parsedURI.Scheme == "unix" {
+		network, hostname, address = "unix", "", parsedURI.Path
+		databasePath = parsedURI.Query().Get("db")
+		if address == "" {
+			return nil, fmt.Errorf("%w: socket path must be specified in the path of a unix URI", ErrInvalidURI)
+		}
 	}
 
 	// Extract database number from path
-	database := 0
-	if parsedURI.Path != "" && parsedURI.Path != "/" {
-		dbStr := strings.TrimPrefix(parsedURI.Path, "/")
-		if db, err := strconv.Atoi(dbStr); err == nil {
-			database = db
-		}
+	database, err := parseDatabase(databasePath)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidURI, err)
 	}
 
-	// Extract password from user info
-	password := ""
+	// Extract the credentials from user info, unless given separately
+	username, password := "", ""
 	if parsedURI.User != nil {
+		username = parsedURI.User.Username()
 		password, _ = parsedURI.User.Password()
 	}
+	if cfg.Username != "" {
+		username = cfg.Username
+	}
+	if cfg.Password != "" {
+		password = cfg.Password
+	}
+	passwordFile := parsedURI.Query().Get("password_file")
+	if cfg.PasswordFile != "" {
+		passwordFile = cfg.PasswordFile
+	}
 
-	// Extract key from query parameters
-	key := parsedURI.Query().Get("key")
-	if key == "" {
-		return nil, errors.New("Redis key must be specified in query parameter 'key'")
+	// Extract keys from query parameters, several keys being merged in order
+	var keys []string
+	for _, key := range parsedURI.Query()["key"] {
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	pattern := parsedURI.Query().Get("pattern")
+	if len(keys) == 0 && pattern == "" {
+		return nil, ErrMissingKey
+	}
+	maxKeys, err := parseMaxKeys(parsedURI.Query())
+	if err != nil {
+		return nil, err
 	}
 
 	// Check for TLS
-	useTLS := parsedURI.Scheme == "rediss"
+	useTLS := parsedURI.Scheme == "rediss" || cfg.TLSConfig != nil
+	tlsOpts, err := parseTLSOptions(parsedURI.Query(), hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for the optional modes
+	modes, err := parseModes(parsedURI.Query())
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for connection timeouts
+	timeouts, err := parseTimeouts(parsedURI.Query())
+	if err != nil {
+		return nil, err
+	}
+	timeouts = timeouts.override(cfg.Timeouts)
+
+	// Check for the connection pool sizing
+	pool, err := parsePoolOptions(parsedURI.Query())
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for the RESP protocol version
+	protocol, err := parseProtocol(parsedURI.Query().Get("protocol"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for Sentinels or a cluster, which the reads may be routed to the replicas of
+	topo, err := parseTopology(parsedURI.Query(), parsedURI.Scheme, address, database, modes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check whether the Redis JSON module is to be used
+	skipJSONModule, err := parseJSONModule(parsedURI.Query())
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for encoded values
+	encoding, err := parseEncoding(parsedURI.Query().Get("encoding"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for compressed values
+	compression, err := parseCompression(parsedURI.Query().Get("compression"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for the format of values
+	format, err := parseFormat(parsedURI.Query().Get("format"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for the expansion of environment variables
+	expandEnv, expandEnvMissing, err := parseExpandEnv(parsedURI.Query())
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for the type of the keys
+	keyType, err := parseKeyType(parsedURI.Query().Get("type"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for the change detection hash
+	hashAlgorithm, err := parseHashAlgorithm(parsedURI.Query().Get("hash"))
+	if err != nil {
+		return nil, err
+	}
+	hashEncoding, err := parseHashEncoding(parsedURI.Query().Get("hash_encoding"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for schema validation
+	schemaValidation, err := parseSchemaValidation(parsedURI.Query().Get("schema"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for the minimum flag count guarding against truncated configurations
+	minFlags, err := parseMinFlags(parsedURI.Query().Get("min_flags"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for the time a key that had data may be missing before fetches fail
+	warnOnMissingAfter, err := parseDurationParam(parsedURI.Query(), "warn_on_missing_after")
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for the shortest time between emits
+	minEmitInterval, err := parseDurationParam(parsedURI.Query(), "min_emit_interval")
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for the policy of a deleted key
+	deletePolicy, err := parseDeletePolicy(parsedURI.Query(), warnOnMissingAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	healthInterval, err := parseHealthInterval(parsedURI.Query(), modes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for the JSON paths of the sections of the configuration
+	paths, err := parsePaths(parsedURI.Query(), keyType, skipJSONModule)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for a pattern discovering the keys instead of fixed ones
+	if err := validatePattern(parsedURI.Query(), keys, modes, keyType); err != nil {
+		return nil, err
+	}
+	keyPrefix := parsedURI.Query().Get("prefix")
+	if pattern != "" {
+		keys = []string{pattern}
+	}
+	keys = prefixKeys(keyPrefix, keys)
+	if pattern != "" {
+		pattern = keys[0]
+	}
+	metadataKey := parsedURI.Query().Get("metadata_key")
+	if metadataKey != "" {
+		metadataKey = keyPrefix + metadataKey
+	}
+	controlKey := parsedURI.Query().Get("control-key")
+	if controlKey != "" {
+		controlKey = keyPrefix + controlKey
+	}
+
+	// Check for a cron expression replacing the interval
+	cronSpec := parsedURI.Query().Get("cron")
+	if cronSpec != "" {
+		if err := validateCronSpec(cronSpec); err != nil {
+			return nil, fmt.Errorf("invalid value for query parameter 'cron': %w", err)
+		}
+	}
+
+	// Check for the policy of a failed initial fetch
+	startPolicy, err := parseStartPolicy(parsedURI.Query().Get("start_policy"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for connection retries
+	connectRetries, connectBackoff, err := parseConnectRetry(parsedURI.Query())
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Retries != nil {
+		connectRetries = *cfg.Retries
+	}
 
 	// Create Redis client options
 	opts := &redis.Options{
-		Addr:     host,
-		Password: password,
-		DB:       database,
+		Network:         network,
+		Addr:            address,
+		Username:        username,
+		Password:        password,
+		DB:              database,
+		DialTimeout:     timeouts.dial,
+		ReadTimeout:     timeouts.read,
+		WriteTimeout:    timeouts.write,
+		Protocol:        protocol,
+		PoolSize:        pool.size,
+		MinIdleConns:    pool.minIdleConns,
+		PoolTimeout:     pool.timeout,
+		ConnMaxIdleTime: pool.connMaxIdleTime,
+		ConnMaxLifetime: pool.connMaxLifetime,
+	}
+
+	switch {
+	case cfg.TLSConfig != nil:
+		opts.TLSConfig = cfg.TLSConfig.Clone()
+	case useTLS:
+		if opts.TLSConfig, err = tlsOpts.config(); err != nil {
+			return nil, err
+		}
+	}
+
+	allowedCommands := parseCommandList(parsedURI.Query().Get("allowed-commands"))
+
+	// the options are shared by the rebuilt clients, keeping the settings changed on the client, such as the database
+	newClient := func() RedisClient {
+		client := topo.newClient(opts)
+		if modes.audit || len(allowedCommands) > 0 {
+			fields := sourceFields(uri, keys[0], parsedURI.Query().Get("name"))
+			client.AddHook(newAuditHook(logger, fields, modes.audit, allowedCommands))
+		}
+		if modes.readOnly {
+			client.AddHook(readOnlyHook{})
+		}
+		return client
+	}
+
+	rs := &Sync{
+		URI:                    uri,
+		Client:                 newClient(),
+		Cron:                   newCron(cronSpec),
+		CronSpec:               cronSpec,
+		Logger:                 logger,
+		Key:                    keys[0],
+		Keys:                   keys,
+		KeyPrefix:              keyPrefix,
+		Pattern:                pattern,
+		MaxKeys:                maxKeys,
+		MetadataKey:            metadataKey,
+		Flags:                  parseFlagList(parsedURI.Query().Get("flags")),
+		Exclude:                parseFlagList(parsedURI.Query().Get("exclude")),
+		Name:                   parsedURI.Query().Get("name"),
+		Path:                   parsedURI.Query().Get("path"),
+		Paths:                  paths,
+		SkipJSONModule:         skipJSONModule,
+		Database:               database,
+		Username:               username,
+		Password:               password,
+		PasswordFile:           passwordFile,
+		CacheFile:              parsedURI.Query().Get("cache_file"),
+		Encoding:               encoding,
+		Compression:            compression,
+		Format:                 format,
+		ExpandEnv:              expandEnv,
+		ExpandEnvMissing:       expandEnvMissing,
+		Type:                   keyType,
+		HashAlgorithm:          hashAlgorithm,
+		HashEncoding:           hashEncoding,
+		TLS:                    useTLS,
+		TLSServerName:          tlsOpts.serverName,
+		TLSSNI:                 tlsOpts.sni,
+		TLSCertFile:            tlsOpts.certFile,
+		TLSKeyFile:             tlsOpts.keyFile,
+		TLSCAFile:              tlsOpts.caFile,
+		TLSInsecureSkipVerify:  tlsOpts.insecureSkipVerify,
+		Interval:               30, // Default to 30 seconds
+		WatchMode:              modes.watch,
+		ConfigureNotifications: modes.configureNotify,
+		ReadOnly:               modes.readOnly,
+		Validate:               modes.validate,
+		SchemaValidation:       schemaValidation,
+		MinFlags:               minFlags,
+		WarnOnMissingAfter:     warnOnMissingAfter,
+		DeletePolicy:           deletePolicy,
+		HealthInterval:         healthInterval,
+		MinEmitInterval:        minEmitInterval,
+		EmitOnReconnect:        modes.emitOnReconnect,
+		StartPolicy:            startPolicy,
+		RequireKey:             modes.requireKey,
+		AuditCommands:          modes.audit,
+		AllowedCommands:        allowedCommands,
+		ControlKey:             controlKey,
+		Channel:                parsedURI.Query().Get("channel"),
+		DialTimeout:            timeouts.dial,
+		ReadTimeout:            timeouts.read,
+		WriteTimeout:           timeouts.write,
+		FetchTimeout:           timeouts.fetch,
+		Protocol:               protocol,
+		PoolSize:               pool.size,
+		MinIdleConns:           pool.minIdleConns,
+		PoolTimeout:            pool.timeout,
+		ConnMaxIdleTime:        pool.connMaxIdleTime,
+		ConnMaxLifetime:        pool.connMaxLifetime,
+		ConnectRetries:         connectRetries,
+		ConnectBackoff:         connectBackoff,
+		FetchRetries:           defaultFetchRetries,
+		metrics:                newMetrics(),
+		newClient:              newClient,
+		clientOptions:          opts,
+		topology:               topo,
+	}
+	if err := rs.applyOptions(options); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// parseDatabase parses the database number of the URI path or db parameter, defaulting to 0 when it is empty
+func parseDatabase(path string) (int, error) {
+	value := strings.TrimPrefix(path, "/")
+	if value == "" {
+		return 0, nil
+	}
+
+	database, err := strconv.Atoi(value)
+	if err != nil || database < 0 {
+		return 0, fmt.Errorf("invalid Redis database %q, expected a non-negative number", value)
+	}
+	return database, nil
+}
+
+// SetDatabase selects the database, overriding the one of the URI. It must be called before Init.
+func (rs *Sync) SetDatabase(database int) error {
+	if database < 0 {
+		return fmt.Errorf("invalid Redis database %d, expected a non-negative number", database)
 	}
 
-	if useTLS {
-		opts.TLSConfig = &tls.Config{
-			ServerName: strings.Split(host, ":")[0],
+	if rs.topology.cluster && database != 0 {
+		return fmt.Errorf("a Redis cluster only has database 0, got %d", database)
+	}
+
+	rs.Database = database
+	rs.setClientOptions(func(opts *redis.Options) {
+		opts.DB = database
+	})
+	return nil
+}
+
+// redisAddress returns the host name, without IPv6 brackets, and the dial address of the URI. The host defaults to
+// localhost and the port to 6379.
+func redisAddress(parsedURI *url.URL) (string, string) {
+	hostname := parsedURI.Hostname()
+	if hostname == "" {
+		hostname = "localhost"
+	}
+
+	port := parsedURI.Port()
+	if port == "" {
+		port = "6379"
+	}
+
+	return hostname, net.JoinHostPort(hostname, port)
+}
+
+// uriModes holds the optional boolean modes set through query parameters
+type uriModes struct {
+	watch           bool
+	readOnly        bool
+	validate        bool
+	emitOnReconnect bool
+	audit           bool
+	requireKey      bool
+	configureNotify bool
+}
+
+// parseModes parses the optional boolean modes from the query parameters
+func parseModes(query url.Values) (uriModes, error) {
+	var parsed uriModes
+	params := []struct {
+		name  string
+		value *bool
+	}{
+		{"watch", &parsed.watch},
+		{"read-only", &parsed.readOnly},
+		{"validate", &parsed.validate},
+		{"emit-on-reconnect", &parsed.emitOnReconnect},
+		{"audit", &parsed.audit},
+		{"require_key", &parsed.requireKey},
+		{"configure_notifications", &parsed.configureNotify},
+	}
+
+	for _, param := range params {
+		value, err := parseBoolParam(query, param.name)
+		if err != nil {
+			return uriModes{}, err
+		}
+		*param.value = value
+	}
+
+	if parsed.configureNotify {
+		if !parsed.watch {
+			return uriModes{}, errors.New("query parameter 'configure_notifications' requires 'watch'")
+		}
+		if parsed.readOnly {
+			return uriModes{}, errors.New("query parameter 'configure_notifications' can't be combined with 'read-only'")
 		}
 	}
 
-	client := redis.NewClient(opts)
+	return parsed, nil
+}
 
-	return &Sync{
-		URI:      uri,
-		Client:   client,
-		Cron:     cron.New(),
-		Logger:   logger,
-		Key:      key,
-		Database: database,
-		Password: password,
-		TLS:      useTLS,
-		Interval: 30, // Default to 30 seconds
-	}, nil
+// uriTimeouts holds the connection timeouts set through query parameters
+type uriTimeouts struct {
+	dial  time.Duration
+	read  time.Duration
+	write time.Duration
+	fetch time.Duration
+}
+
+// parseTimeouts parses the optional connection and fetch timeouts from the query parameters
+func parseTimeouts(query url.Values) (uriTimeouts, error) {
+	var parsed uriTimeouts
+	params := []struct {
+		name  string
+		value *time.Duration
+	}{
+		{"dial_timeout", &parsed.dial},
+		{"read_timeout", &parsed.read},
+		{"write_timeout", &parsed.write},
+		{"fetch_timeout", &parsed.fetch},
+	}
+
+	for _, param := range params {
+		value, err := parseDurationParam(query, param.name)
+		if err != nil {
+			return uriTimeouts{}, err
+		}
+		*param.value = value
+	}
+
+	return parsed, nil
+}
+
+// override returns the timeouts with the non-zero ones of overrides taking precedence
+func (t uriTimeouts) override(overrides Timeouts) uriTimeouts {
+	for _, timeout := range []struct {
+		override time.Duration
+		value    *time.Duration
+	}{
+		{overrides.Dial, &t.dial},
+		{overrides.Read, &t.read},
+		{overrides.Write, &t.write},
+		{overrides.Fetch, &t.fetch},
+	} {
+		if timeout.override > 0 {
+			*timeout.value = timeout.override
+		}
+	}
+	return t
+}
+
+// parseDurationParam parses an optional, non-negative duration query parameter such as 5s, defaulting to zero
+func parseDurationParam(query url.Values, name string) (time.Duration, error) {
+	value := query.Get(name)
+	if value == "" {
+		return 0, nil
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for query parameter '%s': %w", name, err)
+	}
+	if parsed < 0 {
+		return 0, fmt.Errorf("invalid value for query parameter '%s': must not be negative", name)
+	}
+	return parsed, nil
+}
+
+// parseBoolParam parses an optional boolean query parameter, defaulting to false
+func parseBoolParam(query url.Values, name string) (bool, error) {
+	value := query.Get(name)
+	if value == "" {
+		return false, nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid value for query parameter '%s': %w", name, err)
+	}
+	return parsed, nil
 }
 
 // Init initializes the Redis sync provider
 func (rs *Sync) Init(ctx context.Context) error {
+	if rs.PasswordFile != "" {
+		if err := rs.loadPasswordFile(); err != nil {
+			return err
+		}
+	}
+
 	// Test connection
-	if err := rs.Client.Ping(ctx).Err(); err != nil {
-		return fmt.Errorf("failed to connect to Redis: %w", err)
+	if err := rs.connect(ctx); err != nil {
+		if !rs.hasCache() {
+			return fmt.Errorf("failed to connect to Redis: %w", err)
+		}
+		rs.Logger.Warn("failed to connect to Redis, starting from the cached configuration",
+			rs.logFields(zap.Error(err))...)
+	} else {
+		rs.probeServer(ctx)
+		if rs.WatchMode && rs.ConfigureNotifications {
+			if err := rs.configureKeyspaceNotifications(ctx); err != nil {
+				return err
+			}
+		}
 	}
 
-	rs.Logger.Info(fmt.Sprintf("Redis sync provider initialized for key: %s", rs.Key))
+	rs.Logger.Info("Redis sync provider initialized", rs.logFields()...)
 	return nil
 }
 
 // Sync starts the synchronization process
 func (rs *Sync) Sync(ctx context.Context, dataSync chan<- sync.DataSync) error {
-	rs.Logger.Info(fmt.Sprintf("starting Redis sync for key %s with interval %ds", rs.Key, rs.Interval))
-
-	// Add cron job for periodic polling
-	_ = rs.Cron.AddFunc(fmt.Sprintf("*/%d * * * *", rs.Interval), func() {
-		rs.Logger.Debug(fmt.Sprintf("fetching configuration from Redis key: %s", rs.Key))
-		previousSHA := rs.LastSHA
-		data, err := rs.fetchData(ctx)
-		if err != nil {
-			rs.Logger.Error(fmt.Sprintf("error fetching from Redis: %s", err.Error()))
-			return
+	// Release the connection pool once syncing stops
+	defer func() {
+		if err := rs.Close(); err != nil {
+			rs.Logger.Warn("failed to close the Redis connection", rs.logFields(zap.Error(err))...)
 		}
+	}()
 
-		if data == "" {
-			rs.Logger.Debug("Redis key not found or empty")
-			return
+	// Interrupt the polls still in flight once syncing stops and wait for them before the connection is closed. The
+	// cron runs them on goroutines of its own, which stopping it doesn't wait for, so that the caller can safely close
+	// dataSync once Sync returned.
+	defer rs.waitForPolls()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	streaming := rs.Type == typeStream
+
+	// Subscribe before the initial fetch so that no change in between is missed
+	var pubsub, invalidations PubSub
+	if rs.WatchMode && !streaming {
+		pubsub = rs.subscribeKeyspace(ctx)
+	}
+	if rs.Channel != "" && !streaming && pubsub == nil {
+		if invalidations = rs.subscribeChannel(ctx); invalidations != nil {
+			defer invalidations.Close()
 		}
+	}
 
-		if previousSHA == "" {
-			rs.Logger.Debug("configuration created")
-			dataSync <- sync.DataSync{FlagData: data, Source: rs.URI}
-		} else if previousSHA != rs.LastSHA {
-			rs.Logger.Debug("configuration updated")
-			dataSync <- sync.DataSync{FlagData: data, Source: rs.URI}
+	switch {
+	case streaming:
+		// the initial fetch records the position new entries are read from, so none is missed
+		rs.Logger.Info("starting Redis sync of the stream reading new entries", rs.logFields()...)
+	case pubsub != nil:
+		rs.Logger.Info("starting Redis sync using keyspace notifications", rs.logFields()...)
+		defer pubsub.Close()
+	default:
+		if err := rs.schedulePolling(ctx, dataSync); err != nil {
+			return err
 		}
-	})
+	}
 
 	// Initial fetch
-	rs.Logger.Debug(fmt.Sprintf("initial sync of Redis key: %s", rs.Key))
-	data, err := rs.fetchData(ctx)
-	if err != nil {
+	rs.Logger.Debug("initial sync of Redis key", rs.logFields()...)
+	previousSHA := rs.currentSHA()
+	data, err := rs.initialFetch(ctx)
+	switch {
+	case err != nil && !rs.retriesStart():
 		return fmt.Errorf("initial Redis fetch failed: %w", err)
+	case err != nil:
+		// stay not ready, the first successful poll marking the provider ready
+		rs.Logger.Error("initial Redis fetch failed, retrying in the background", rs.logFields(zap.Error(err))...)
+		rs.mu.Lock()
+		rs.disconnected = true
+		rs.mu.Unlock()
+		rs.notifyError(err)
+	case data == "" && rs.RequireKey:
+		return fmt.Errorf("initial Redis fetch failed: %w: %s", ErrKeyNotFound, rs.Key)
+	default:
+		if data != "" && rs.changedSince(previousSHA) {
+			rs.emit(ctx, dataSync, data)
+		}
+		rs.mu.Lock()
+		rs.ready = true
+		rs.mu.Unlock()
 	}
 
-	if data != "" {
-		dataSync <- sync.DataSync{FlagData: data, Source: rs.URI}
+	// Ping Redis between fetches until syncing stops, waiting for the health check before the client is closed
+	if rs.HealthInterval > 0 {
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			rs.monitorHealth(ctx)
+		}()
+		defer func() {
+			cancel()
+			<-done
+		}()
+	}
+
+	if streaming {
+		return rs.readStream(ctx, dataSync)
+	}
+	if pubsub != nil {
+		return rs.watch(ctx, pubsub, dataSync)
 	}
 
-	rs.ready = true
 	rs.Cron.Start()
+	defer rs.Cron.Stop()
+
+	if invalidations != nil {
+		return rs.listenInvalidations(ctx, invalidations, dataSync)
+	}
 
 	// Wait for context cancellation
 	<-ctx.Done()
-	rs.Cron.Stop()
 
 	return nil
 }
 
-// ReSync performs a full resynchronization
+// poll fetches the configuration and emits it when it was created or changed
+func (rs *Sync) poll(ctx context.Context, dataSync chan<- sync.DataSync) {
+	rs.pollMu.Lock()
+	defer rs.pollMu.Unlock()
+
+	// a poll fired by the cron as syncing stops must not fetch nor emit, dataSync may already be closed
+	if ctx.Err() != nil {
+		return
+	}
+
+	rs.Logger.Debug("fetching configuration from Redis key", rs.logFields()...)
+	previousSHA := rs.currentSHA()
+	start := time.Now()
+	data, err := rs.fetchData(ctx)
+	duration := time.Since(start)
+	if ctx.Err() != nil {
+		// syncing stopped during the fetch, which is neither a failure nor to be emitted
+		rs.Logger.Debug("poll of Redis key interrupted", rs.logFields(zap.Error(ctx.Err()))...)
+		return
+	}
+	if err != nil {
+		rs.notifyError(err)
+	}
+	if errors.Is(err, ErrInvalidConfiguration) {
+		rs.Logger.Error("keeping the last known-good configuration", rs.logFields(zap.Error(err))...)
+		return
+	}
+	if errors.Is(err, ErrKeyMissing) {
+		rs.Logger.Warn("keeping the last known configuration", rs.logFields(zap.Error(err))...)
+		return
+	}
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			rs.Logger.Error("fetch from Redis timed out",
+				rs.logFields(zap.Error(err), zap.Duration("timeout", rs.fetchTimeout()))...)
+		} else {
+			rs.Logger.Error("error fetching from Redis",
+				rs.logFields(zap.Error(err), zap.Duration("duration", duration))...)
+		}
+		rs.mu.Lock()
+		rs.disconnected = true
+		rs.mu.Unlock()
+		return
+	}
+
+	rs.mu.Lock()
+	reconnected := rs.disconnected
+	rs.disconnected = false
+	rs.ready = true
+	rs.mu.Unlock()
+
+	if data == "" {
+		if rs.clearDeleted(ctx, dataSync, previousSHA) {
+			return
+		}
+		rs.Logger.Debug("Redis key not found or empty", rs.logFields(zap.Duration("duration", duration))...)
+		return
+	}
+
+	fields := rs.logFields(
+		zap.String("sha", rs.currentSHA()),
+		zap.Duration("duration", duration),
+		zap.Int("byteSize", len(data)),
+	)
+	switch {
+	case previousSHA == "":
+		rs.Logger.Debug("configuration created", fields...)
+		rs.metrics.configUpdated()
+		rs.emitChange(ctx, dataSync, data)
+	case previousSHA != rs.currentSHA():
+		rs.Logger.Debug("configuration updated", fields...)
+		rs.metrics.configUpdated()
+		rs.emitChange(ctx, dataSync, data)
+	case reconnected && rs.EmitOnReconnect:
+		// subscribers may have missed changes while Redis was unreachable
+		rs.Logger.Debug("emitting configuration after reconnect", fields...)
+		rs.emit(ctx, dataSync, data)
+	}
+}
+
+// waitForPolls blocks until the poll in flight, if any, completed. Polls starting later find their context cancelled
+// and return without emitting.
+func (rs *Sync) waitForPolls() {
+	rs.pollMu.Lock()
+	defer rs.pollMu.Unlock()
+
+	rs.Logger.Debug("polling of Redis key stopped", rs.logFields()...)
+}
+
+// emit sends the configuration on dataSync unless ctx is cancelled, so that a poll completing while syncing stops
+// neither blocks on a channel no longer read nor sends to one being closed
+func (rs *Sync) emit(ctx context.Context, dataSync chan<- sync.DataSync, data string) {
+	if ctx.Err() != nil {
+		rs.Logger.Debug("syncing stopped, not emitting the configuration", rs.logFields()...)
+		return
+	}
+
+	select {
+	case dataSync <- sync.DataSync{FlagData: data, Source: rs.dataSource()}:
+		rs.recordEmit()
+	case <-ctx.Done():
+	}
+}
+
+// ReSync performs a full resynchronization, emitting the configuration unless it is unchanged since the last fetch
 func (rs *Sync) ReSync(ctx context.Context, dataSync chan<- sync.DataSync) error {
+	return rs.resync(ctx, dataSync, false)
+}
+
+// ForceReSync performs a full resynchronization emitting the configuration even when it is unchanged, for a store
+// that dropped the flags of the source and requires them to be sent again
+func (rs *Sync) ForceReSync(ctx context.Context, dataSync chan<- sync.DataSync) error {
+	return rs.resync(ctx, dataSync, true)
+}
+
+// resync fetches the configuration and emits it when it changed since the last fetch or force is set
+func (rs *Sync) resync(ctx context.Context, dataSync chan<- sync.DataSync, force bool) error {
+	previousSHA := rs.currentSHA()
 	data, err := rs.fetchData(ctx)
 	if err != nil {
 		return fmt.Errorf("Redis resync failed: %w", err)
 	}
 
-	if data != "" {
-		dataSync <- sync.DataSync{FlagData: data, Source: rs.URI}
+	if data != "" && (force || rs.changedSince(previousSHA)) {
+		rs.emit(ctx, dataSync, data)
 	}
 
 	return nil
 }
 
-// IsReady returns true if the provider is ready
+// changedSince reports whether the configuration last fetched differs from the one hashed as previousSHA, the first
+// configuration ever fetched always counting as changed
+func (rs *Sync) changedSince(previousSHA string) bool {
+	if previousSHA != "" && previousSHA == rs.currentSHA() {
+		rs.Logger.Debug("configuration is unchanged, skipping emit", rs.logFields(zap.String("sha", previousSHA))...)
+		return false
+	}
+	return true
+}
+
+// IsReady returns true if the provider is ready, which it isn't while the health check fails
 func (rs *Sync) IsReady() bool {
-	return rs.ready
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+
+	return rs.ready && !rs.unhealthy
+}
+
+// currentSHA returns the hash of the last fetched configuration
+func (rs *Sync) currentSHA() string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+
+	return rs.LastSHA
 }
 
-// fetchData retrieves and processes data from Redis
+// Fetch fetches the configuration once, as converted JSON, without emitting it. It returns an empty string when the
+// key is missing or empty.
+func (rs *Sync) Fetch(ctx context.Context) (string, error) {
+	return rs.fetchData(ctx)
+}
+
+// fetchData retrieves and processes data from Redis, recording the outcome in the metrics and stats
 func (rs *Sync) fetchData(ctx context.Context) (string, error) {
-	// Try JSON.GET first (Redis JSON module)
-	jsonResult := rs.Client.JSONGet(ctx, rs.Key, ".")
-	if jsonResult.Err() == nil {
-		// Successfully used Redis JSON module
-		var jsonData interface{}
-		var err error
-		jsonData, err = jsonResult.Result()
-		if err != nil {
-			return "", fmt.Errorf("failed to get result from Redis JSON command: %w", err)
-		}
+	// Bound the fetch so that a stuck command doesn't block the polling goroutine, delaying the next polls
+	if timeout := rs.fetchTimeout(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
 
-		if jsonData == nil {
-			return "", nil
-		}
+	data, err := rs.fetchConfiguration(ctx)
+	if err == nil {
+		err = rs.checkMissingKey(ctx, data)
+	}
+	rs.recordFetch(data, err)
 
-		// Convert the result to string
-		jsonString, ok := jsonData.(string)
-		if !ok {
-			return "", fmt.Errorf("unexpected data type from Redis JSON.GET: %T", jsonData)
+	if err == nil && data != "" && rs.CacheFile != "" {
+		if err := rs.writeCache(data); err != nil {
+			rs.Logger.Warn("unable to write the Redis cache file",
+				rs.logFields(zap.String("cacheFile", rs.CacheFile), zap.Error(err))...)
 		}
+	}
 
-		if jsonString == "" {
-			return "", nil
-		}
+	return data, err
+}
 
-		// Convert to standard JSON format if needed
-		convertedJSON, err := utils.ConvertToJSON([]byte(jsonString), ".json", "application/json")
-		if err != nil {
-			return "", fmt.Errorf("error converting Redis JSON to standard format: %w", err)
-		}
+// fetchTimeout returns the deadline of a fetch, FetchTimeout or else ReadTimeout, zero leaving it unbounded
+func (rs *Sync) fetchTimeout() time.Duration {
+	if rs.FetchTimeout > 0 {
+		return rs.FetchTimeout
+	}
+	return rs.ReadTimeout
+}
 
-		// Generate SHA for change detection
-		if convertedJSON != "" {
-			rs.LastSHA = rs.generateSHA([]byte(convertedJSON))
+// fetchConfiguration fetches the configuration from the synced keys and validates it if enabled
+func (rs *Sync) fetchConfiguration(ctx context.Context) (string, error) {
+	start := time.Now()
+	var data string
+	var err error
+	switch {
+	case rs.Pattern != "":
+		data, err = rs.fetchPattern(ctx)
+	case len(rs.Keys) > 1:
+		data, err = rs.fetchMerged(ctx, rs.Keys)
+	default:
+		data, err = rs.fetchKey(ctx, rs.Key)
+	}
+	// the metadata document is read on its own, the method being the one of the flag keys
+	method := rs.fetchMethod()
+	if err == nil {
+		data, err = rs.withMetadata(ctx, data)
+	}
+	rs.metrics.observeFetch(method, time.Since(start), data, err)
+	if err != nil || data == "" {
+		return data, err
+	}
+
+	// Keep the listed flags only, so that changes of the other flags don't change LastSHA
+	if data, err = rs.filterFlags(data); err != nil {
+		return "", err
+	}
+
+	// Reject invalid documents, so that they don't change LastSHA and the last known-good data stays in effect
+	if rs.Validate {
+		if err := validateConfiguration(data); err != nil {
+			rs.metrics.validationFailed()
+			return "", err
 		}
+	}
+
+	return data, nil
+}
+
+// recordFetch updates the stats with the outcome of a fetch, and the SHA and sync lag with the fetched data
+func (rs *Sync) recordFetch(data string, err error) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	rs.fetchCount++
+	rs.lastError = err
+	if err != nil {
+		return
+	}
 
-		return convertedJSON, nil
+	rs.lastSyncTime = time.Now()
+	if data != "" {
+		// Generate SHA for change detection
+		rs.LastSHA = rs.generateSHA([]byte(data))
+		rs.recordSyncLag(data)
 	}
+}
 
-	// Fallback to regular GET if JSON module is not available or key doesn't exist
-	if jsonResult.Err() != redis.Nil {
-		rs.Logger.Debug(fmt.Sprintf("Redis JSON.GET failed, falling back to GET: %v", jsonResult.Err()))
+// fetchDocument retrieves the document of key, preferring the Redis JSON module over a plain GET unless
+// SkipJSONModule is set or the server was found to lack the module. The document is returned verbatim rather than
+// decoded and re-encoded, so numbers such as large integer variants keep their exact value.
+func (rs *Sync) fetchDocument(ctx context.Context, key string, path string) (string, error) {
+	if rs.useJSONModule(path) {
+		// Try JSON.GET first (Redis JSON module)
+		var jsonResult *redis.JSONCmd
+		_ = rs.withRetry(ctx, "JSON.GET", func() error {
+			jsonResult = rs.client().JSONGet(ctx, key, path)
+			return jsonResult.Err()
+		})
+		rs.recordJSONModule(jsonResult.Err())
+		if jsonResult.Err() == nil {
+			// Successfully used Redis JSON module
+			rs.recordJSONModuleUsed(true)
+			return rs.decodeJSONGet(key, jsonResult, path)
+		}
+
+		// Fallback to regular GET if JSON module is not available or key doesn't exist
+		if jsonResult.Err() != redis.Nil {
+			if !isRootPath(path) {
+				return "", fmt.Errorf("JSON path %s requires the Redis JSON module: %w", path,
+					jsonResult.Err())
+			}
+			rs.Logger.Debug("Redis JSON.GET failed, falling back to GET",
+				zap.String("key", key), zap.Error(jsonResult.Err()))
+		}
 	}
 
 	// Use GET to retrieve the JSON document stored as a string
-	result := rs.Client.Get(ctx, rs.Key)
+	rs.recordJSONModuleUsed(false)
+	var result *redis.StringCmd
+	_ = rs.withRetry(ctx, "GET", func() error {
+		result = rs.client().Get(ctx, key)
+		return result.Err()
+	})
 	if err := result.Err(); err != nil {
 		if err == redis.Nil {
 			// Key doesn't exist
@@ -252,35 +1295,164 @@ func (rs *Sync) fetchData(ctx context.Context) (string, error) {
 		return "", fmt.Errorf("failed to get data from Redis: %w", err)
 	}
 
-	jsonString := result.Val()
+	return rs.decodeGet(key, result.Val())
+}
+
+// decodeGet returns the document of the string value of key read with GET, decoded, decompressed and converted to
+// JSON
+func (rs *Sync) decodeGet(key string, value string) (string, error) {
+	decoded, err := rs.decodeValue(key, value)
+	if err != nil {
+		return "", err
+	}
+	jsonString, err := rs.decompress(key, decoded)
+	if err != nil {
+		return "", err
+	}
 	if jsonString == "" {
 		return "", nil
 	}
+	if jsonString, err = rs.transformValue(key, jsonString); err != nil {
+		return "", err
+	}
 
 	// Convert to standard JSON format if needed
-	convertedJSON, err := utils.ConvertToJSON([]byte(jsonString), ".json", "application/json")
+	convertedJSON, err := rs.convertToJSON(jsonString)
 	if err != nil {
 		return "", fmt.Errorf("error converting Redis data to standard JSON format: %w", err)
 	}
 
-	// Generate SHA for change detection
-	if convertedJSON != "" {
-		rs.LastSHA = rs.generateSHA([]byte(convertedJSON))
+	return convertedJSON, nil
+}
+
+// decodeJSONGet returns the document of key of a successful JSON.GET, the first match for a JSONPath query
+func (rs *Sync) decodeJSONGet(key string, jsonResult *redis.JSONCmd, path string) (string, error) {
+	var jsonData interface{}
+	var err error
+	jsonData, err = jsonResult.Result()
+	if err != nil {
+		return "", fmt.Errorf("failed to get result from Redis JSON command: %w", err)
+	}
+
+	if jsonData == nil {
+		return "", nil
+	}
+
+	// Convert the result to string
+	jsonString, ok := jsonData.(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected data type from Redis JSON.GET: %T", jsonData)
+	}
+
+	return rs.decodeJSONDocument(key, jsonString, path)
+}
+
+// decodeJSONDocument returns the document of key read at path with the Redis JSON module, the first match for a
+// JSONPath query
+func (rs *Sync) decodeJSONDocument(key string, jsonString string, path string) (string, error) {
+	var err error
+	// JSONPath queries return an array of matches
+	if strings.HasPrefix(path, "$") {
+		if jsonString, err = firstJSONPathMatch(jsonString); err != nil {
+			return "", err
+		}
+	}
+
+	if jsonString == "" {
+		return "", nil
+	}
+	if jsonString, err = rs.transformValue(key, jsonString); err != nil {
+		return "", err
+	}
+
+	// Convert to standard JSON format if needed
+	convertedJSON, err := utils.ConvertToJSON([]byte(jsonString), ".json", "application/json")
+	if err != nil {
+		return "", fmt.Errorf("error converting Redis JSON to standard format: %w", err)
 	}
 
 	return convertedJSON, nil
 }
 
-// generateSHA generates a SHA hash for change detection
-func (rs *Sync) generateSHA(data []byte) string {
-	hasher := sha3.New256()
-	hasher.Write(data)
-	return base64.URLEncoding.EncodeToString(hasher.Sum(nil))
+// recordSyncLag updates the sync lag from the document's lastModified timestamp, if present. The caller holds rs.mu.
+func (rs *Sync) recordSyncLag(data string) {
+	lag, ok, err := computeSyncLag(data, time.Now())
+	if err != nil {
+		rs.Logger.Debug("unable to determine sync lag", rs.logFields(zap.Error(err))...)
+	}
+
+	rs.syncLag = lag
+	rs.syncLagKnown = ok
+	if ok {
+		rs.metrics.setSyncLag(lag)
+	}
+}
+
+// SyncLag returns how far the last fetched document trails its own lastModified timestamp, in seconds.
+// The boolean is false when the document carries no usable timestamp.
+func (rs *Sync) SyncLag() (float64, bool) {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+
+	return rs.syncLag.Seconds(), rs.syncLagKnown
+}
+
+// Stats describes the health of the synchronization
+type Stats struct {
+	// LastSyncTime is the time of the last successful fetch, zero before any
+	LastSyncTime time.Time
+	// LastError is the error of the last fetch, nil when it succeeded
+	LastError error
+	// FetchCount counts the fetches, successful or not
+	FetchCount uint64
+	// LastSHA is the hash of the last fetched configuration
+	LastSHA string
+	// JSONModule is whether the last document was read with the Redis JSON module
+	JSONModule bool
+	// Server describes the server found by Init
+	Server ServerInfo
 }
 
-// SetInterval sets the polling interval
+// Stats returns a snapshot of the synchronization health
+func (rs *Sync) Stats() Stats {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+
+	return Stats{
+		LastSyncTime: rs.lastSyncTime,
+		LastError:    rs.lastError,
+		FetchCount:   rs.fetchCount,
+		LastSHA:      rs.LastSHA,
+		JSONModule:   rs.jsonModuleUsed,
+		Server:       rs.serverInfo,
+	}
+}
+
+// SetInterval sets the polling interval in seconds, an interval of zero being raised to minInterval as it would
+// never poll. A running sync polls on the new interval from the next tick on, unless the control key overrides it.
 func (rs *Sync) SetInterval(interval uint32) {
+	if interval < minInterval {
+		rs.Logger.Warn("invalid Redis polling interval, using the minimum",
+			rs.logFields(zap.Uint32("interval", interval), zap.Uint32("minInterval", minInterval))...)
+		interval = minInterval
+	}
+
+	rs.mu.Lock()
 	rs.Interval = interval
+	reschedule := rs.pollingScheduled && rs.controlInterval == 0
+	rs.mu.Unlock()
+
+	if reschedule {
+		rs.reschedulePolling(interval)
+	}
+}
+
+// configuredInterval returns the polling interval set through SetInterval or the configuration
+func (rs *Sync) configuredInterval() uint32 {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+
+	return rs.Interval
 }
 
 // NewRedisSyncFromConfig creates a new Redis sync provider from SourceConfig
@@ -303,10 +1475,34 @@ func NewRedisSyncFromConfig(config sync.SourceConfig, logger *logger.Logger) (*S
 	return rs, nil
 }
 
-// Close closes the Redis connection
-func (rs *Sync) Close() error {
-	if rs.Client != nil {
-		return rs.Client.Close()
+// RedactURI masks the password of a Redis URI so that it can be logged safely
+func RedactURI(uri string) string {
+	parsedURI, err := url.Parse(expandUserinfo(uri))
+	if err != nil {
+		// an unparsable URI cannot be redacted reliably, so don't reveal any of it
+		return "<invalid Redis URI>"
 	}
-	return nil
+
+	if parsedURI.User == nil {
+		return uri
+	}
+	if _, ok := parsedURI.User.Password(); !ok {
+		return uri
+	}
+
+	username := parsedURI.User.Username()
+	parsedURI.User = nil
+	prefix := parsedURI.Scheme + "://"
+	return prefix + url.User(username).String() + ":" + redactedPassword + "@" +
+		strings.TrimPrefix(parsedURI.String(), prefix)
+}
+
+// Close closes the Redis connection. It is safe to call more than once, only the first call closing the client.
+func (rs *Sync) Close() error {
+	rs.closeOnce.Do(func() {
+		if client := rs.client(); client != nil {
+			rs.closeErr = client.Close()
+		}
+	})
+	return rs.closeErr
 }
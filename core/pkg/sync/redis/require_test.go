@@ -0,0 +1,84 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_RequireKey(t *testing.T) {
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags", logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.False(t, rs.RequireKey)
+
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags&require_key=true", logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.True(t, rs.RequireKey)
+
+	_, err = NewRedisSync("redis://localhost:6379/0?key=flags&require_key=maybe", logger.NewLogger(zap.NewNop(), false))
+	require.ErrorContains(t, err, "require_key")
+}
+
+func TestRedisSync_SyncWithMissingKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		requireKey bool
+	}{
+		{name: "lenient", requireKey: false},
+		{name: "strict", requireKey: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockRedisClient{}
+			missingKey(mockClient, "test-key")
+			mockClient.On("Close").Return(nil)
+
+			mockCron := &MockCron{}
+			mockCron.On("AddFunc", mock.Anything, mock.Anything).Return(nil)
+			mockCron.On("Start").Return()
+			mockCron.On("Stop").Return()
+
+			rs := &Sync{
+				URI:        "redis://localhost:6379/0?key=test-key",
+				Client:     mockClient,
+				Cron:       mockCron,
+				Logger:     logger.NewLogger(zap.NewNop(), false),
+				Key:        "test-key",
+				Interval:   30,
+				RequireKey: tt.requireKey,
+			}
+			dataSync := make(chan sync.DataSync, 1)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			errs := make(chan error, 1)
+			go func() {
+				errs <- rs.Sync(ctx, dataSync)
+			}()
+
+			if tt.requireKey {
+				err := <-errs
+				require.ErrorIs(t, err, ErrKeyNotFound)
+				assert.ErrorContains(t, err, "test-key")
+				assert.False(t, rs.IsReady())
+				return
+			}
+
+			// the service starts empty
+			require.Eventually(t, rs.IsReady, time.Second, 10*time.Millisecond)
+			assert.Empty(t, dataSync)
+			cancel()
+			require.NoError(t, <-errs)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultFetchRetries is the number of times a fetch command failing with a transient error is retried
+	defaultFetchRetries = 2
+	// fetchRetryBackoff is the delay before the first retry of a fetch command, doubling after each retry
+	fetchRetryBackoff = 100 * time.Millisecond
+)
+
+// withRetry runs command, retrying it FetchRetries times with a short backoff while it fails with a transient error
+func (rs *Sync) withRetry(ctx context.Context, name string, command func() error) error {
+	delay := fetchRetryBackoff
+	for attempt := 1; ; attempt++ {
+		err := command()
+		if !isTransient(err) || attempt > rs.FetchRetries {
+			return err
+		}
+
+		rs.Logger.Debug("Redis command failed, retrying", rs.logFields(zap.String("command", name),
+			zap.Duration("delay", delay), zap.Int("retry", attempt), zap.Int("retries", rs.FetchRetries), zap.Error(err))...)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		delay *= 2
+	}
+}
+
+// isTransient reports whether err is a connection-level error worth retrying. A missing key, a reply from the server,
+// such as the unknown command of a server without the JSON module, and errors raised by the client hooks are not.
+func isTransient(err error) bool {
+	if err == nil || errors.Is(err, redis.Nil) {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, ErrReadOnly) || errors.Is(err, ErrCommandNotAllowed) || isUnknownCommand(err) {
+		return false
+	}
+
+	var redisErr redis.Error
+	return !errors.As(err, &redisErr)
+}
+
+// isUnknownCommand reports whether err is the reply of a server not supporting the command
+func isUnknownCommand(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "unknown command")
+}
@@ -0,0 +1,116 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "no error", err: nil, expected: false},
+		{name: "missing key", err: redis.Nil, expected: false},
+		{name: "connection reset", err: errors.New("read tcp: connection reset by peer"), expected: true},
+		{name: "unexpected EOF", err: io.EOF, expected: true},
+		{name: "unknown command", err: errors.New("ERR unknown command 'JSON.GET'"), expected: false},
+		{name: "cancelled", err: context.Canceled, expected: false},
+		{name: "read-only", err: fmt.Errorf("%w: set", ErrReadOnly), expected: false},
+		{name: "not allowed", err: fmt.Errorf("%w: get", ErrCommandNotAllowed), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isTransient(tt.err))
+		})
+	}
+}
+
+func TestRedisSync_fetchDataRetriesTransientErrors(t *testing.T) {
+	flagData := `{"flags":{"test":{"state":"ENABLED"}}}`
+
+	dropped := &redis.JSONCmd{}
+	dropped.SetErr(errors.New("read tcp: connection reset by peer"))
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(dropped).Once()
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(flagData)).Once()
+
+	rs := &Sync{
+		Client:       mockClient,
+		Logger:       logger.NewLogger(zap.NewNop(), false),
+		Key:          "test-key",
+		FetchRetries: 2,
+	}
+
+	data, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, flagData, data)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_fetchDataDoesNotRetryPermanentErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		jsonErr error
+	}{
+		{name: "missing key", jsonErr: redis.Nil},
+		{name: "JSON module missing", jsonErr: errors.New("ERR unknown command 'JSON.GET'")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonResult := &redis.JSONCmd{}
+			jsonResult.SetErr(tt.jsonErr)
+
+			mockClient := &MockRedisClient{}
+			mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonResult).Once()
+			mockClient.On("Get", mock.Anything, "test-key").Return(redis.NewStringResult("", redis.Nil)).Once()
+
+			rs := &Sync{
+				Client:       mockClient,
+				Logger:       logger.NewLogger(zap.NewNop(), false),
+				Key:          "test-key",
+				FetchRetries: 2,
+			}
+
+			data, err := rs.fetchData(context.Background())
+			require.NoError(t, err)
+			assert.Empty(t, data)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRedisSync_fetchDataGivesUpAfterRetries(t *testing.T) {
+	dropped := &redis.JSONCmd{}
+	dropped.SetErr(errors.New("connection refused"))
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(dropped).Times(2)
+	mockClient.On("Get", mock.Anything, "test-key").
+		Return(redis.NewStringResult("", errors.New("connection refused"))).Times(2)
+
+	rs := &Sync{
+		Client:       mockClient,
+		Logger:       logger.NewLogger(zap.NewNop(), false),
+		Key:          "test-key",
+		FetchRetries: 1,
+	}
+
+	_, err := rs.fetchData(context.Background())
+	require.ErrorContains(t, err, "connection refused")
+	mockClient.AssertExpectations(t)
+}
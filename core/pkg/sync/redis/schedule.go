@@ -0,0 +1,105 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/robfig/cron"
+	"go.uber.org/zap"
+)
+
+// specCron implements the Cron interface for standard 5-field cron expressions, such as "*/5 9-17 * * 1-5"
+type specCron struct {
+	*cron.Cron
+}
+
+func newSpecCron() specCron {
+	return specCron{cron.New()}
+}
+
+// AddFunc registers cmd to run on the schedule of the standard cron expression spec
+func (c specCron) AddFunc(spec string, cmd func()) error {
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return fmt.Errorf("invalid cron expression %q: %w", spec, err)
+	}
+	c.Schedule(schedule, cron.FuncJob(cmd))
+	return nil
+}
+
+// newCron returns the Cron running the polling schedule, the ticker unless a cron expression is set
+func newCron(spec string) Cron {
+	if spec == "" {
+		return newTickerCron()
+	}
+	return newSpecCron()
+}
+
+// validateCronSpec checks that spec is a valid standard cron expression
+func validateCronSpec(spec string) error {
+	if _, err := cron.ParseStandard(spec); err != nil {
+		return fmt.Errorf("invalid cron expression %q: %w", spec, err)
+	}
+	return nil
+}
+
+// SetCronSpec polls on the standard cron expression spec instead of the interval. The expression is validated up
+// front, so that a malformed one fails here rather than leaving a provider that never polls.
+func (rs *Sync) SetCronSpec(spec string) error {
+	if err := validateCronSpec(spec); err != nil {
+		return err
+	}
+
+	rs.CronSpec = spec
+	rs.Cron = newCron(spec)
+	return nil
+}
+
+// schedulePolling registers the polling of the configuration on the schedule, skipped while the control key pauses
+// it
+func (rs *Sync) schedulePolling(ctx context.Context, dataSync chan<- sync.DataSync) error {
+	schedule := rs.schedule()
+	rs.Logger.Info("starting Redis sync on schedule", rs.logFields(zap.String("schedule", schedule))...)
+
+	err := rs.Cron.AddFunc(schedule, func() {
+		if rs.applyControl(ctx) {
+			rs.Logger.Debug("polling of Redis key is paused by the control key", rs.logFields()...)
+			return
+		}
+		rs.poll(ctx, dataSync)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to schedule polling of Redis key %s: %w", rs.Key, err)
+	}
+
+	if rs.CronSpec == "" {
+		rs.mu.Lock()
+		rs.pollingScheduled = true
+		rs.mu.Unlock()
+	}
+	return nil
+}
+
+// reschedulePolling resets the running polling schedule to interval, the next poll being an interval from now
+func (rs *Sync) reschedulePolling(interval uint32) {
+	cron, ok := rs.Cron.(rescheduler)
+	if !ok {
+		rs.Logger.Warn("the polling schedule doesn't support changing the interval of a running sync")
+		return
+	}
+	if err := cron.Reschedule(fmt.Sprintf("@every %ds", interval)); err != nil {
+		rs.Logger.Warn("unable to change the polling interval of Redis key", rs.logFields(zap.Error(err))...)
+		return
+	}
+
+	rs.Logger.Info("polling Redis key", rs.logFields(zap.Uint32("interval", interval))...)
+}
+
+// schedule returns the polling schedule, the cron expression when set or one derived from the interval
+func (rs *Sync) schedule() string {
+	if rs.CronSpec != "" {
+		return rs.CronSpec
+	}
+	return fmt.Sprintf("@every %ds", rs.configuredInterval())
+}
@@ -0,0 +1,180 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_CronSpec(t *testing.T) {
+	tests := []struct {
+		name             string
+		uri              string
+		expectError      bool
+		expectedSchedule string
+	}{
+		{
+			name:             "interval",
+			uri:              "redis://localhost:6379/0?key=flags",
+			expectedSchedule: "@every 30s",
+		},
+		{
+			name:             "business hours",
+			uri:              "redis://localhost:6379/0?key=flags&cron=*/5+9-17+*+*+1-5",
+			expectedSchedule: "*/5 9-17 * * 1-5",
+		},
+		{
+			name:             "descriptor",
+			uri:              "redis://localhost:6379/0?key=flags&cron=@hourly",
+			expectedSchedule: "@hourly",
+		},
+		{
+			name:        "malformed expression",
+			uri:         "redis://localhost:6379/0?key=flags&cron=*/5+25+*+*+*",
+			expectError: true,
+		},
+		{
+			name:        "seconds field",
+			uri:         "redis://localhost:6379/0?key=flags&cron=0+*/5+*+*+*+*",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, err := NewRedisSync(tt.uri, logger.NewLogger(zap.NewNop(), false))
+			if tt.expectError {
+				require.ErrorContains(t, err, "cron")
+				return
+			}
+			require.NoError(t, err)
+			defer rs.Close()
+
+			assert.Equal(t, tt.expectedSchedule, rs.schedule())
+			require.NoError(t, rs.Cron.AddFunc(rs.schedule(), func() {}))
+		})
+	}
+}
+
+func TestRedisSync_SetCronSpec(t *testing.T) {
+	rs := &Sync{Cron: newTickerCron(), Interval: 30}
+
+	require.Error(t, rs.SetCronSpec("not a schedule"))
+	assert.Empty(t, rs.CronSpec)
+	assert.IsType(t, &tickerCron{}, rs.Cron)
+
+	require.NoError(t, rs.SetCronSpec("0 8 * * *"))
+	assert.Equal(t, "0 8 * * *", rs.schedule())
+	assert.IsType(t, specCron{}, rs.Cron)
+}
+
+func TestRedisSync_SyncSurfacesSchedulingError(t *testing.T) {
+	mockCron := &MockCron{}
+	mockCron.On("AddFunc", "@every 0s", mock.Anything).Return(errors.New("invalid schedule"))
+	mockClient := &MockRedisClient{}
+	mockClient.On("Close").Return(nil)
+
+	rs := &Sync{
+		Client: mockClient,
+		Cron:   mockCron,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "test-key",
+	}
+
+	err := rs.Sync(context.Background(), make(chan sync.DataSync, 1))
+	require.ErrorContains(t, err, "invalid schedule")
+	assert.False(t, rs.IsReady())
+	mockCron.AssertExpectations(t)
+}
+
+func TestRedisSync_SyncRejectsZeroInterval(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("Close").Return(nil)
+
+	rs := &Sync{
+		Client: mockClient,
+		Cron:   newTickerCron(),
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "test-key",
+	}
+
+	err := rs.Sync(context.Background(), make(chan sync.DataSync, 1))
+	require.ErrorContains(t, err, "interval must be positive")
+}
+
+func TestRedisSync_SetIntervalBounds(t *testing.T) {
+	tests := []struct {
+		name             string
+		interval         uint32
+		expectedSchedule string
+	}{
+		{name: "zero is raised to the minimum", interval: 0, expectedSchedule: "@every 1s"},
+		{name: "minimum", interval: 1, expectedSchedule: "@every 1s"},
+		{name: "one day", interval: 86400, expectedSchedule: "@every 86400s"},
+		{name: "largest", interval: math.MaxUint32, expectedSchedule: "@every 4294967295s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := &Sync{
+				Cron:   newTickerCron(),
+				Logger: logger.NewLogger(zap.NewNop(), false),
+			}
+
+			rs.SetInterval(tt.interval)
+			assert.Equal(t, tt.expectedSchedule, rs.schedule())
+			require.NoError(t, rs.Cron.AddFunc(rs.schedule(), func() {}))
+		})
+	}
+}
+
+func TestRedisSync_SetIntervalReschedulesRunningSync(t *testing.T) {
+	initial := `{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+	updated := `{"flags":{"test":{"state":"DISABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(initial)).Once()
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(updated))
+	mockClient.On("Close").Return(nil)
+
+	cron := newTickerCron()
+	rs := &Sync{
+		URI:      "redis://localhost:6379/0?key=test-key",
+		Client:   mockClient,
+		Cron:     cron,
+		Logger:   logger.NewLogger(zap.NewNop(), false),
+		Key:      "test-key",
+		Interval: 60,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dataSync := make(chan sync.DataSync, 1)
+	done := make(chan error, 1)
+	go func() {
+		done <- rs.Sync(ctx, dataSync)
+	}()
+	assert.Equal(t, initial, receive(t, dataSync))
+
+	// the next poll follows the new interval rather than the minute scheduled when syncing started
+	rs.SetInterval(1)
+	assert.Equal(t, time.Second, cron.interval)
+	select {
+	case data := <-dataSync:
+		assert.Equal(t, updated, data.FlagData)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the new polling interval was not applied to the running sync")
+	}
+
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,112 @@
+package redis
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// jsonModuleName is the name the RedisJSON module is listed under by MODULE LIST
+const jsonModuleName = "ReJSON"
+
+// ServerInfo describes the Redis server, as found by Init
+type ServerInfo struct {
+	// Version is the redis_version reported by INFO server, empty when it couldn't be read
+	Version string
+	// Modules are the names of the modules loaded by the server, nil when MODULE LIST isn't permitted
+	Modules []string
+}
+
+// JSONModule reports whether the server loaded the RedisJSON module, known being false when its modules couldn't be
+// listed
+func (i ServerInfo) JSONModule() (loaded bool, known bool) {
+	if i.Modules == nil {
+		return false, false
+	}
+	for _, name := range i.Modules {
+		if strings.EqualFold(name, jsonModuleName) {
+			return true, true
+		}
+	}
+	return false, true
+}
+
+// ModuleList lists the modules loaded by the server, which go-redis has no command for
+func (c goRedisClient) ModuleList(ctx context.Context) *redis.SliceCmd {
+	cmd := redis.NewSliceCmd(ctx, "module", "list")
+	_ = c.Process(ctx, cmd)
+	return cmd
+}
+
+// probeServer records the version and modules of the server for debugging command support. INFO and MODULE are
+// commonly restricted on managed servers, what can't be read is left unknown.
+func (rs *Sync) probeServer(ctx context.Context) {
+	var info ServerInfo
+	if reply, err := rs.client().Info(ctx, "server").Result(); err != nil {
+		rs.Logger.Debug("unable to read the Redis server version", rs.logFields(zap.Error(err))...)
+	} else {
+		info.Version = parseServerVersion(reply)
+	}
+
+	if reply, err := rs.client().ModuleList(ctx).Result(); err != nil {
+		rs.Logger.Debug("unable to list the Redis server modules", rs.logFields(zap.Error(err))...)
+	} else {
+		info.Modules = parseModuleNames(reply)
+	}
+
+	rs.mu.Lock()
+	rs.serverInfo = info
+	rs.mu.Unlock()
+
+	jsonModule := zap.String("jsonModule", "unknown")
+	if loaded, known := info.JSONModule(); known {
+		jsonModule = zap.Bool("jsonModule", loaded)
+	}
+	rs.Logger.Info("connected to Redis server", rs.logFields(zap.String("version", info.Version), jsonModule)...)
+}
+
+// ServerInfo returns the version and modules of the server found by Init
+func (rs *Sync) ServerInfo() ServerInfo {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+
+	return rs.serverInfo
+}
+
+// parseServerVersion returns the redis_version field of an INFO reply, empty when it has none
+func parseServerVersion(info string) string {
+	scanner := bufio.NewScanner(strings.NewReader(info))
+	for scanner.Scan() {
+		if version, ok := strings.CutPrefix(scanner.Text(), "redis_version:"); ok {
+			return strings.TrimSpace(version)
+		}
+	}
+	return ""
+}
+
+// parseModuleNames returns the names of the modules of a MODULE LIST reply, whose entries are flat lists of fields
+// and values with RESP2 and maps with RESP3
+func parseModuleNames(reply []interface{}) []string {
+	names := []string{}
+	for _, entry := range reply {
+		var name interface{}
+		switch fields := entry.(type) {
+		case []interface{}:
+			for i := 0; i+1 < len(fields); i += 2 {
+				if fields[i] == "name" {
+					name = fields[i+1]
+				}
+			}
+		case map[interface{}]interface{}:
+			name = fields["name"]
+		}
+		if name != nil {
+			names = append(names, fmt.Sprint(name))
+		}
+	}
+	return names
+}
@@ -0,0 +1,111 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+const infoServer = "# Server\r\n" +
+	"redis_version:7.2.4\r\n" +
+	"redis_git_sha1:00000000\r\n" +
+	"redis_mode:standalone\r\n" +
+	"os:Linux 6.1.0 x86_64\r\n"
+
+// restrictedServer mocks a server refusing INFO and MODULE LIST, as managed servers commonly do
+func restrictedServer(m *MockRedisClient) {
+	noPerm := errors.New("NOPERM this user has no permissions to run this command")
+	m.On("Info", mock.Anything, []string{"server"}).Return(redis.NewStringResult("", noPerm)).Once()
+	m.On("ModuleList", mock.Anything).Return(redis.NewSliceResult(nil, noPerm)).Once()
+}
+
+func TestParseServerVersion(t *testing.T) {
+	assert.Equal(t, "7.2.4", parseServerVersion(infoServer))
+	assert.Empty(t, parseServerVersion("# Server\r\nredis_mode:standalone\r\n"))
+}
+
+func TestParseModuleNames(t *testing.T) {
+	resp2 := []interface{}{
+		[]interface{}{"name", "ReJSON", "ver", int64(20609), "path", "/usr/lib/redis/modules/rejson.so"},
+		[]interface{}{"name", "search", "ver", int64(21005)},
+	}
+	assert.Equal(t, []string{"ReJSON", "search"}, parseModuleNames(resp2))
+
+	resp3 := []interface{}{
+		map[interface{}]interface{}{"name": "ReJSON", "ver": int64(20609)},
+	}
+	assert.Equal(t, []string{"ReJSON"}, parseModuleNames(resp3))
+
+	assert.Equal(t, []string{}, parseModuleNames(nil))
+}
+
+func TestServerInfo_JSONModule(t *testing.T) {
+	loaded, known := ServerInfo{Modules: []string{"search", "rejson"}}.JSONModule()
+	assert.True(t, loaded)
+	assert.True(t, known)
+
+	loaded, known = ServerInfo{Modules: []string{}}.JSONModule()
+	assert.False(t, loaded)
+	assert.True(t, known)
+
+	_, known = ServerInfo{}.JSONModule()
+	assert.False(t, known)
+}
+
+func TestRedisSync_InitRecordsServerInfo(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("Ping", mock.Anything).Return(redis.NewStatusResult("PONG", nil)).Once()
+	mockClient.On("Info", mock.Anything, []string{"server"}).Return(redis.NewStringResult(infoServer, nil)).Once()
+	mockClient.On("ModuleList", mock.Anything).Return(redis.NewSliceResult([]interface{}{
+		[]interface{}{"name", "ReJSON", "ver", int64(20609)},
+	}, nil)).Once()
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.New(core), false),
+		Key:    "flags",
+	}
+
+	require.NoError(t, rs.Init(context.Background()))
+	assert.Equal(t, ServerInfo{Version: "7.2.4", Modules: []string{"ReJSON"}}, rs.ServerInfo())
+	assert.Equal(t, rs.ServerInfo(), rs.Stats().Server)
+
+	entries := logs.FilterMessage("connected to Redis server").All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "7.2.4", entries[0].ContextMap()["version"])
+	assert.Equal(t, true, entries[0].ContextMap()["jsonModule"])
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_InitWithRestrictedServerInfo(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("Ping", mock.Anything).Return(redis.NewStatusResult("PONG", nil)).Once()
+	restrictedServer(mockClient)
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.New(core), false),
+		Key:    "flags",
+	}
+
+	require.NoError(t, rs.Init(context.Background()))
+	assert.Equal(t, ServerInfo{}, rs.ServerInfo())
+
+	entries := logs.FilterMessage("connected to Redis server").All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "unknown", entries[0].ContextMap()["jsonModule"])
+	mockClient.AssertExpectations(t)
+}
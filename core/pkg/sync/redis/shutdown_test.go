@@ -0,0 +1,170 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	msync "sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRedisSync_CancelInterruptsPoll(t *testing.T) {
+	initial := `{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+	updated := `{"flags":{"test":{"state":"DISABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+
+	fetching := make(chan struct{})
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).Return(jsonCmd(initial)).Once()
+	// the poll blocks in the fetch until syncing stops, then completes with a changed configuration
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).Run(func(args mock.Arguments) {
+		close(fetching)
+		<-args.Get(0).(context.Context).Done()
+	}).Return(jsonCmd(updated)).Once()
+	mockClient.On("Close").Return(nil)
+
+	mockCron := &MockCron{}
+	mockCron.On("AddFunc", "@every 30s", mock.Anything).Return(nil)
+	mockCron.On("Start").Return()
+	mockCron.On("Stop").Return()
+
+	rs := &Sync{
+		URI:      "redis://localhost:6379/0?key=flags",
+		Client:   mockClient,
+		Cron:     mockCron,
+		Logger:   logger.NewLogger(zap.NewNop(), false),
+		Key:      "flags",
+		Interval: 30,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dataSync := make(chan sync.DataSync, 1)
+	synced := make(chan error, 1)
+	go func() {
+		synced <- rs.Sync(ctx, dataSync)
+	}()
+	require.Equal(t, initial, (<-dataSync).FlagData)
+	require.Eventually(t, rs.IsReady, time.Second, 10*time.Millisecond)
+
+	polled := make(chan any, 1)
+	go func() {
+		defer func() {
+			polled <- recover()
+		}()
+		mockCron.TriggerFunc(0)
+	}()
+	<-fetching
+
+	cancel()
+	require.NoError(t, <-synced)
+	close(dataSync)
+
+	select {
+	case panicked := <-polled:
+		assert.Nil(t, panicked, fmt.Sprintf("poll panicked: %v", panicked))
+	case <-time.After(time.Second):
+		t.Fatal("poll not interrupted by the cancellation")
+	}
+	for data := range dataSync {
+		t.Fatalf("unexpected emit after cancellation: %s", data.FlagData)
+	}
+}
+
+// changingClient serves a different configuration on every fetch, so that every poll emits
+type changingClient struct {
+	*MockRedisClient
+	fetches atomic.Int64
+}
+
+func (c *changingClient) JSONGet(_ context.Context, _ string, _ ...string) *redis.JSONCmd {
+	return jsonCmd(fmt.Sprintf(`{"flags":{},"metadata":{"fetch":%d}}`, c.fetches.Add(1)))
+}
+
+// burstCron fires its jobs back to back, each on a goroutine of its own as the cron does, until stopped
+type burstCron struct {
+	mu   msync.Mutex
+	jobs []func()
+	stop chan struct{}
+}
+
+func (c *burstCron) AddFunc(_ string, cmd func()) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.jobs = append(c.jobs, cmd)
+	return nil
+}
+
+func (c *burstCron) Start() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stop = make(chan struct{})
+	go func(stop chan struct{}, jobs []func()) {
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			for _, job := range jobs {
+				go job()
+			}
+			time.Sleep(100 * time.Microsecond)
+		}
+	}(c.stop, c.jobs)
+}
+
+func (c *burstCron) Stop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	close(c.stop)
+}
+
+// TestRedisSync_ShutdownStress runs rapid poll and shutdown cycles, closing dataSync as soon as Sync returns. Meant
+// to be run with -race, a send after the close panicking.
+func TestRedisSync_ShutdownStress(t *testing.T) {
+	for cycle := range 50 {
+		mockClient := &MockRedisClient{}
+		mockClient.On("Close").Return(nil)
+
+		rs := &Sync{
+			URI:      "redis://localhost:6379/0?key=flags",
+			Client:   &changingClient{MockRedisClient: mockClient},
+			Cron:     &burstCron{},
+			Logger:   logger.NewLogger(zap.NewNop(), false),
+			Key:      "flags",
+			Interval: 1,
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		dataSync := make(chan sync.DataSync, 1)
+		synced := make(chan error, 1)
+		go func() {
+			synced <- rs.Sync(ctx, dataSync)
+		}()
+
+		// read a few emits, leaving the later polls blocked on the full channel
+		for range 1 + cycle%5 {
+			<-dataSync
+		}
+		cancel()
+
+		select {
+		case err := <-synced:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("cycle %d: Sync didn't return after cancellation", cycle)
+		}
+		close(dataSync)
+	}
+
+	// let the jobs fired last run against the closed channels
+	time.Sleep(10 * time.Millisecond)
+}
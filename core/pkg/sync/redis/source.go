@@ -0,0 +1,18 @@
+package redis
+
+// SourceName returns the Name of the provider, or its URI with the password redacted when it has none. It identifies
+// the provider in logs, metrics and failed fetches.
+func (rs *Sync) SourceName() string {
+	if rs.Name != "" {
+		return rs.Name
+	}
+	return RedactURI(rs.URI)
+}
+
+// dataSource returns the source of the emitted configurations, the Name of the provider or its URI
+func (rs *Sync) dataSource() string {
+	if rs.Name != "" {
+		return rs.Name
+	}
+	return rs.URI
+}
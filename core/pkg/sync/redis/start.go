@@ -0,0 +1,31 @@
+package redis
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// startPolicyFail fails Sync when the initial fetch fails, the default
+	startPolicyFail = "fail"
+	// startPolicyRetry starts syncing not ready when the initial fetch fails, polling until a fetch succeeds
+	startPolicyRetry = "retry"
+)
+
+// parseStartPolicy validates the start_policy query parameter, defaulting to fail
+func parseStartPolicy(value string) (string, error) {
+	switch strings.ToLower(value) {
+	case "", startPolicyFail:
+		return startPolicyFail, nil
+	case startPolicyRetry:
+		return startPolicyRetry, nil
+	default:
+		return "", fmt.Errorf("unsupported value for query parameter 'start_policy': %s, expected retry or fail",
+			value)
+	}
+}
+
+// retriesStart reports whether a failed initial fetch leaves the provider polling rather than failing Sync
+func (rs *Sync) retriesStart() bool {
+	return rs.StartPolicy == startPolicyRetry
+}
@@ -0,0 +1,101 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_StartPolicy(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags", log)
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.Equal(t, startPolicyFail, rs.StartPolicy)
+
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags&start_policy=retry", log)
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.Equal(t, startPolicyRetry, rs.StartPolicy)
+
+	_, err = NewRedisSync("redis://localhost:6379/0?key=flags&start_policy=wait", log)
+	require.ErrorContains(t, err, "start_policy")
+}
+
+func TestRedisSync_SyncWithFailedInitialFetch(t *testing.T) {
+	flagData := `{"flags":{"test":{"state":"ENABLED"}}}`
+
+	tests := []struct {
+		name   string
+		policy string
+	}{
+		{name: "fail", policy: startPolicyFail},
+		{name: "retry", policy: startPolicyRetry},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockRedisClient{}
+			unreachable(mockClient, "test-key")
+			mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(flagData)).Maybe()
+			mockClient.On("Close").Return(nil)
+
+			mockCron := &MockCron{}
+			mockCron.On("AddFunc", "@every 30s", mock.Anything).Return(nil)
+			mockCron.On("Start").Return()
+			mockCron.On("Stop").Return()
+
+			errs := make(chan SyncError, 1)
+			rs := &Sync{
+				URI:         "redis://localhost:6379/0?key=test-key",
+				Client:      mockClient,
+				Cron:        mockCron,
+				Logger:      logger.NewLogger(zap.NewNop(), false),
+				Key:         "test-key",
+				Interval:    30,
+				StartPolicy: tt.policy,
+				Errors:      errs,
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			dataSync := make(chan sync.DataSync, 1)
+			done := make(chan error, 1)
+			go func() {
+				done <- rs.Sync(ctx, dataSync)
+			}()
+
+			if tt.policy == startPolicyFail {
+				require.ErrorContains(t, <-done, "initial Redis fetch failed")
+				assert.False(t, rs.IsReady())
+				return
+			}
+
+			// the provider keeps syncing, not ready until a poll succeeds
+			select {
+			case syncErr := <-errs:
+				require.ErrorContains(t, syncErr.Err, "connection refused")
+			case <-time.After(time.Second):
+				t.Fatal("the failed initial fetch was not reported")
+			}
+			assert.False(t, rs.IsReady())
+			assert.Empty(t, dataSync)
+
+			mockCron.TriggerFunc(0)
+			assert.Equal(t, flagData, receive(t, dataSync))
+			assert.True(t, rs.IsReady())
+
+			cancel()
+			require.NoError(t, <-done)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
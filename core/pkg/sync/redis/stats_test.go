@@ -0,0 +1,72 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestRedisSync_Stats(t *testing.T) {
+	flagData := `{"flags":{"test":{"state":"ENABLED"}}}`
+
+	unreachableJSON := &redis.JSONCmd{}
+	unreachableJSON.SetErr(errors.New("connection refused"))
+	unreachableGet := redis.NewStringCmd(context.Background())
+	unreachableGet.SetErr(errors.New("connection refused"))
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(flagData)).Once()
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(unreachableJSON).Once()
+	mockClient.On("Get", mock.Anything, "test-key").Return(unreachableGet).Once()
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(flagData)).Once()
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "test-key",
+	}
+
+	stats := rs.Stats()
+	assert.Zero(t, stats.FetchCount)
+	assert.True(t, stats.LastSyncTime.IsZero())
+	assert.NoError(t, stats.LastError)
+	assert.Empty(t, stats.LastSHA)
+
+	// successful fetch
+	_, err := rs.fetchData(context.Background())
+	assert.NoError(t, err)
+
+	stats = rs.Stats()
+	assert.Equal(t, uint64(1), stats.FetchCount)
+	assert.False(t, stats.LastSyncTime.IsZero())
+	assert.NoError(t, stats.LastError)
+	assert.Equal(t, rs.generateSHA([]byte(flagData)), stats.LastSHA)
+	firstSync := stats.LastSyncTime
+
+	// failed fetch keeps the last sync time and SHA
+	_, err = rs.fetchData(context.Background())
+	assert.Error(t, err)
+
+	stats = rs.Stats()
+	assert.Equal(t, uint64(2), stats.FetchCount)
+	assert.Equal(t, firstSync, stats.LastSyncTime)
+	assert.ErrorContains(t, stats.LastError, "connection refused")
+	assert.Equal(t, rs.generateSHA([]byte(flagData)), stats.LastSHA)
+
+	// recovery clears the error
+	_, err = rs.fetchData(context.Background())
+	assert.NoError(t, err)
+
+	stats = rs.Stats()
+	assert.Equal(t, uint64(3), stats.FetchCount)
+	assert.False(t, stats.LastSyncTime.Before(firstSync))
+	assert.NoError(t, stats.LastError)
+
+	mockClient.AssertExpectations(t)
+}
@@ -0,0 +1,114 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	// typeStream is the type query parameter value for streams whose entries are revisions of the configuration
+	typeStream = "stream"
+	// streamField is the field of a stream entry holding the configuration
+	streamField = "flags"
+	// streamStart is the ID preceding every entry, read from while a stream is empty
+	streamStart = "0-0"
+	// streamRetryDelay is the delay before reading a stream again after a failed read
+	streamRetryDelay = time.Second
+)
+
+// fetchStream returns the configuration held by the latest entry of the stream key, read like a value read with GET,
+// remembering its ID as the position to read new entries from. An empty or missing stream returns an empty string.
+func (rs *Sync) fetchStream(ctx context.Context, key string) (string, error) {
+	var result *redis.XMessageSliceCmd
+	_ = rs.withRetry(ctx, "XREVRANGE", func() error {
+		result = rs.client().XRevRangeN(ctx, key, "+", "-", 1)
+		return result.Err()
+	})
+	if err := result.Err(); err != nil {
+		return "", fmt.Errorf("failed to read stream from Redis: %w", err)
+	}
+
+	entries := result.Val()
+	if len(entries) == 0 {
+		rs.setStreamID(key, streamStart)
+		return "", nil
+	}
+
+	latest := entries[0]
+	rs.setStreamID(key, latest.ID)
+
+	value, ok := latest.Values[streamField].(string)
+	if !ok {
+		return "", fmt.Errorf("entry %s of Redis stream %s has no %s field", latest.ID, key, streamField)
+	}
+	return rs.decodeGet(key, value)
+}
+
+// setStreamID records id as the last entry read from the stream key
+func (rs *Sync) setStreamID(key, id string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	if rs.streamIDs == nil {
+		rs.streamIDs = make(map[string]string)
+	}
+	rs.streamIDs[key] = id
+}
+
+// streamArgs returns the XREAD arguments reading the entries following the last ones read from every synced key
+func (rs *Sync) streamArgs(block time.Duration) *redis.XReadArgs {
+	keys := rs.syncedKeys()
+	streams := make([]string, 0, 2*len(keys))
+	streams = append(streams, keys...)
+
+	rs.mu.RLock()
+	for _, key := range keys {
+		id, ok := rs.streamIDs[key]
+		if !ok {
+			id = streamStart
+		}
+		streams = append(streams, id)
+	}
+	rs.mu.RUnlock()
+
+	return &redis.XReadArgs{Streams: streams, Block: block}
+}
+
+// readStream blocks on new stream entries and fetches and emits the configuration whenever one arrives, until ctx
+// is cancelled. Reads time out after the polling interval to fetch the latest entry anyway, which picks up a stream
+// that was trimmed, deleted or recreated with lower IDs in the meantime.
+func (rs *Sync) readStream(ctx context.Context, dataSync chan<- sync.DataSync) error {
+	for ctx.Err() == nil {
+		block := time.Duration(max(rs.configuredInterval(), minInterval)) * time.Second
+		err := rs.client().XRead(ctx, rs.streamArgs(block)).Err()
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case errors.Is(err, redis.Nil):
+			rs.Logger.Debug("no new entry in Redis stream", rs.logFields(zap.Duration("block", block))...)
+		case err != nil:
+			rs.Logger.Error("error reading Redis stream", rs.logFields(zap.Error(err))...)
+			rs.notifyError(err)
+
+			timer := time.NewTimer(streamRetryDelay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil
+			case <-timer.C:
+			}
+			continue
+		default:
+			rs.Logger.Debug("new entry in Redis stream", rs.logFields()...)
+		}
+
+		rs.poll(ctx, dataSync)
+	}
+	return nil
+}
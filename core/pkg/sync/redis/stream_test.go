@@ -0,0 +1,232 @@
+package redis
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// streamEntries returns the XREVRANGE result of stream entries
+func streamEntries(entries ...redis.XMessage) *redis.XMessageSliceCmd {
+	return redis.NewXMessageSliceCmdResult(entries, nil)
+}
+
+// streamEntry returns a stream entry holding the configuration flags
+func streamEntry(id, flags string) redis.XMessage {
+	return redis.XMessage{ID: id, Values: map[string]interface{}{streamField: flags}}
+}
+
+// readsFrom matches XREAD arguments reading the stream key after id
+func readsFrom(key, id string) interface{} {
+	return mock.MatchedBy(func(args *redis.XReadArgs) bool {
+		return len(args.Streams) == 2 && args.Streams[0] == key && args.Streams[1] == id
+	})
+}
+
+// blockUntilCancelled makes the XREAD call block until its context is cancelled, as a blocking read does. The
+// returned channel is closed once the call blocks.
+func blockUntilCancelled(call *mock.Call) <-chan struct{} {
+	blocked := make(chan struct{})
+	call.Run(func(args mock.Arguments) {
+		close(blocked)
+		<-args.Get(0).(context.Context).Done()
+	}).Return(redis.NewXStreamSliceCmdResult(nil, context.Canceled)).Once()
+	return blocked
+}
+
+// waitFor waits for the blocking read
+func waitFor(t *testing.T, blocked <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-blocked:
+	case <-time.After(time.Second):
+		t.Fatal("the stream was not read from the expected entry")
+	}
+}
+
+// runStreamSync runs Sync on a stream until the returned cancel function is called
+func runStreamSync(t *testing.T, mockClient *MockRedisClient) (chan sync.DataSync, func()) {
+	t.Helper()
+
+	rs := &Sync{
+		URI:      "redis://localhost:6379/0?key=test-key&type=stream",
+		Client:   mockClient,
+		Cron:     &MockCron{},
+		Logger:   logger.NewLogger(zap.NewNop(), false),
+		Key:      "test-key",
+		Type:     typeStream,
+		Interval: 30,
+		metrics:  newMetrics(),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dataSync := make(chan sync.DataSync, 2)
+	done := make(chan error, 1)
+	go func() {
+		done <- rs.Sync(ctx, dataSync)
+	}()
+
+	return dataSync, func() {
+		cancel()
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("Sync did not return after cancellation")
+		}
+	}
+}
+
+// receive returns the next emitted configuration
+func receive(t *testing.T, dataSync chan sync.DataSync) string {
+	t.Helper()
+
+	select {
+	case data := <-dataSync:
+		return data.FlagData
+	case <-time.After(time.Second):
+		t.Fatal("no configuration was emitted")
+		return ""
+	}
+}
+
+func TestRedisSync_StreamEmitsNewEntries(t *testing.T) {
+	first := `{"flags":{"test":{"state":"ENABLED"}}}`
+	second := `{"flags":{"test":{"state":"DISABLED"}}}`
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("XRevRangeN", mock.Anything, "test-key", "+", "-", int64(1)).
+		Return(streamEntries(streamEntry("1-0", first))).Once()
+	mockClient.On("XRead", mock.Anything, readsFrom("test-key", "1-0")).
+		Return(redis.NewXStreamSliceCmdResult([]redis.XStream{
+			{Stream: "test-key", Messages: []redis.XMessage{streamEntry("2-0", second)}},
+		}, nil)).Once()
+	mockClient.On("XRevRangeN", mock.Anything, "test-key", "+", "-", int64(1)).
+		Return(streamEntries(streamEntry("2-0", second)))
+	blocked := blockUntilCancelled(mockClient.On("XRead", mock.Anything, readsFrom("test-key", "2-0")))
+	mockClient.On("Close").Return(nil)
+
+	dataSync, stop := runStreamSync(t, mockClient)
+	assert.Equal(t, first, receive(t, dataSync))
+	assert.Equal(t, second, receive(t, dataSync))
+
+	waitFor(t, blocked)
+	stop()
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_StreamEmptyUntilFirstEntry(t *testing.T) {
+	flags := `{"flags":{"test":{"state":"ENABLED"}}}`
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("XRevRangeN", mock.Anything, "test-key", "+", "-", int64(1)).Return(streamEntries()).Once()
+	mockClient.On("XRead", mock.Anything, readsFrom("test-key", streamStart)).
+		Return(redis.NewXStreamSliceCmdResult([]redis.XStream{
+			{Stream: "test-key", Messages: []redis.XMessage{streamEntry("1-0", flags)}},
+		}, nil)).Once()
+	mockClient.On("XRevRangeN", mock.Anything, "test-key", "+", "-", int64(1)).
+		Return(streamEntries(streamEntry("1-0", flags)))
+	blocked := blockUntilCancelled(mockClient.On("XRead", mock.Anything, readsFrom("test-key", "1-0")))
+	mockClient.On("Close").Return(nil)
+
+	dataSync, stop := runStreamSync(t, mockClient)
+	assert.Equal(t, flags, receive(t, dataSync))
+
+	waitFor(t, blocked)
+	stop()
+	assert.Empty(t, dataSync)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_StreamRecreatedWithLowerIDs(t *testing.T) {
+	first := `{"flags":{"test":{"state":"ENABLED"}}}`
+	recreated := `{"flags":{"test":{"state":"DISABLED"}}}`
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("XRevRangeN", mock.Anything, "test-key", "+", "-", int64(1)).
+		Return(streamEntries(streamEntry("5-0", first))).Once()
+	// entries added after the stream was deleted have lower IDs, so reading after 5-0 only times out
+	mockClient.On("XRead", mock.Anything, readsFrom("test-key", "5-0")).
+		Return(redis.NewXStreamSliceCmdResult(nil, redis.Nil)).Once()
+	mockClient.On("XRevRangeN", mock.Anything, "test-key", "+", "-", int64(1)).
+		Return(streamEntries(streamEntry("1-0", recreated)))
+	blocked := blockUntilCancelled(mockClient.On("XRead", mock.Anything, readsFrom("test-key", "1-0")))
+	mockClient.On("Close").Return(nil)
+
+	dataSync, stop := runStreamSync(t, mockClient)
+	assert.Equal(t, first, receive(t, dataSync))
+	assert.Equal(t, recreated, receive(t, dataSync))
+
+	waitFor(t, blocked)
+	stop()
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_fetchStreamRequiresFlagsField(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("XRevRangeN", mock.Anything, "test-key", "+", "-", int64(1)).
+		Return(streamEntries(redis.XMessage{ID: "1-0", Values: map[string]interface{}{"config": "{}"}}))
+
+	rs := &Sync{
+		Client: mockClient,
+		Logger: logger.NewLogger(zap.NewNop(), false),
+		Key:    "test-key",
+		Type:   typeStream,
+	}
+
+	_, err := rs.fetchStream(context.Background(), "test-key")
+	require.ErrorContains(t, err, "entry 1-0 of Redis stream test-key has no flags field")
+}
+
+func TestRedisSync_fetchStreamDecodesEntries(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding string
+		format   string
+		value    string
+	}{
+		{
+			name:   "yaml",
+			format: formatYAML,
+			value: "flags:\n  test:\n    state: ENABLED\n    variants:\n      \"on\": true\n" +
+				"    defaultVariant: \"on\"\n",
+		},
+		{
+			name:     "base64",
+			encoding: valueEncodingBase64,
+			value: base64.StdEncoding.EncodeToString(
+				[]byte(`{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockRedisClient{}
+			mockClient.On("XRevRangeN", mock.Anything, "test-key", "+", "-", int64(1)).
+				Return(streamEntries(streamEntry("1-0", tt.value)))
+
+			rs := &Sync{
+				Client:   mockClient,
+				Logger:   logger.NewLogger(zap.NewNop(), false),
+				Key:      "test-key",
+				Type:     typeStream,
+				Encoding: tt.encoding,
+				Format:   tt.format,
+			}
+
+			data, err := rs.fetchStream(context.Background(), "test-key")
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`, data)
+		})
+	}
+}
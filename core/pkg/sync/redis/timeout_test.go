@@ -0,0 +1,151 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestNewRedisSync_Timeouts(t *testing.T) {
+	tests := []struct {
+		name          string
+		uri           string
+		expectError   bool
+		expectedDial  time.Duration
+		expectedRead  time.Duration
+		expectedWrite time.Duration
+	}{
+		{
+			name: "defaults",
+			uri:  "redis://localhost:6379/0?key=flags",
+		},
+		{
+			name:          "all timeouts",
+			uri:           "redis://localhost:6379/0?key=flags&dial_timeout=5s&read_timeout=3s&write_timeout=500ms",
+			expectedDial:  5 * time.Second,
+			expectedRead:  3 * time.Second,
+			expectedWrite: 500 * time.Millisecond,
+		},
+		{
+			name:        "invalid duration",
+			uri:         "redis://localhost:6379/0?key=flags&read_timeout=3",
+			expectError: true,
+		},
+		{
+			name:        "negative duration",
+			uri:         "redis://localhost:6379/0?key=flags&dial_timeout=-1s",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, err := NewRedisSync(tt.uri, logger.NewLogger(zap.NewNop(), false))
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			defer rs.Close()
+
+			assert.Equal(t, tt.expectedDial, rs.DialTimeout)
+			assert.Equal(t, tt.expectedRead, rs.ReadTimeout)
+			assert.Equal(t, tt.expectedWrite, rs.WriteTimeout)
+
+			options := rs.Client.(goRedisClient).Options()
+			if tt.expectedDial > 0 {
+				assert.Equal(t, tt.expectedDial, options.DialTimeout)
+				assert.Equal(t, tt.expectedRead, options.ReadTimeout)
+				assert.Equal(t, tt.expectedWrite, options.WriteTimeout)
+			}
+		})
+	}
+}
+
+func TestRedisSync_fetchDataBoundedByReadTimeout(t *testing.T) {
+	var deadline time.Time
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Run(func(args mock.Arguments) {
+		deadline, _ = args.Get(0).(context.Context).Deadline()
+	}).Return(jsonCmd(`{"flags":{}}`))
+
+	rs := &Sync{
+		Client:      mockClient,
+		Logger:      logger.NewLogger(zap.NewNop(), false),
+		Key:         "test-key",
+		ReadTimeout: 3 * time.Second,
+	}
+
+	_, err := rs.fetchData(context.Background())
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(3*time.Second), deadline, time.Second)
+}
+
+func TestNewRedisSync_FetchTimeout(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags&read_timeout=3s&fetch_timeout=10s", log)
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Second, rs.FetchTimeout)
+	assert.Equal(t, 10*time.Second, rs.fetchTimeout())
+
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags&read_timeout=3s", log)
+	require.NoError(t, err)
+	assert.Equal(t, 3*time.Second, rs.fetchTimeout())
+
+	_, err = NewRedisSync("redis://localhost:6379/0?key=flags&fetch_timeout=soon", log)
+	require.ErrorContains(t, err, "fetch_timeout")
+}
+
+func TestRedisSync_pollCancelsFetchBeyondFetchTimeout(t *testing.T) {
+	// blocked commands only return once their context is cancelled
+	blockedJSON := func(args mock.Arguments) {
+		<-args.Get(0).(context.Context).Done()
+	}
+	timedOutJSON := &redis.JSONCmd{}
+	timedOutJSON.SetErr(context.DeadlineExceeded)
+	timedOutGet := redis.NewStringCmd(context.Background())
+	timedOutGet.SetErr(context.DeadlineExceeded)
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).Run(blockedJSON).Return(timedOutJSON).Once()
+	mockClient.On("Get", mock.Anything, "flags").Return(timedOutGet).Once()
+	mockClient.On("JSONGet", mock.Anything, "flags", mock.Anything).
+		Return(jsonCmd(`{"flags":{"test":{"state":"ENABLED"}}}`)).Once()
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	rs := &Sync{
+		Client:       mockClient,
+		Logger:       logger.NewLogger(zap.New(core), false),
+		Key:          "flags",
+		ReadTimeout:  time.Minute,
+		FetchTimeout: 50 * time.Millisecond,
+	}
+
+	dataSync := make(chan sync.DataSync, 1)
+	start := time.Now()
+	rs.poll(context.Background(), dataSync)
+	// the fetch is cancelled at FetchTimeout rather than ReadTimeout
+	assert.Less(t, time.Since(start), time.Second)
+
+	timedOut := logs.FilterMessage("fetch from Redis timed out").All()
+	require.Len(t, timedOut, 1)
+	assert.EqualValues(t, rs.FetchTimeout, timedOut[0].ContextMap()["timeout"])
+	require.ErrorIs(t, rs.Stats().LastError, context.DeadlineExceeded)
+
+	// the next poll isn't held up by the cancelled one
+	rs.poll(context.Background(), dataSync)
+	require.NoError(t, rs.Stats().LastError)
+	require.Len(t, dataSync, 1)
+	mockClient.AssertExpectations(t)
+}
@@ -0,0 +1,123 @@
+package redis
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+)
+
+// tlsOptions holds the TLS settings set through query parameters
+type tlsOptions struct {
+	serverName         string
+	sni                string
+	certFile           string
+	keyFile            string
+	caFile             string
+	insecureSkipVerify bool
+}
+
+// parseTLSOptions parses the TLS settings from the query parameters, defaulting the server name to the host name
+func parseTLSOptions(query url.Values, hostname string) (tlsOptions, error) {
+	opts := tlsOptions{
+		serverName: query.Get("tls-server-name"),
+		sni:        query.Get("tls-sni"),
+		certFile:   query.Get("tls_cert"),
+		keyFile:    query.Get("tls_key"),
+		caFile:     query.Get("tls_ca"),
+	}
+	if opts.serverName == "" {
+		opts.serverName = hostname
+	}
+	if opts.sni == "" {
+		opts.sni = opts.serverName
+	}
+	if (opts.certFile == "") != (opts.keyFile == "") {
+		return tlsOptions{}, errors.New("query parameters 'tls_cert' and 'tls_key' must be set together")
+	}
+
+	insecureSkipVerify, err := parseBoolParam(query, "tls_insecure_skip_verify")
+	if err != nil {
+		return tlsOptions{}, err
+	}
+	opts.insecureSkipVerify = insecureSkipVerify
+
+	return opts, nil
+}
+
+// config builds the client TLS configuration, loading the client certificate and root CA files
+func (o tlsOptions) config() (*tls.Config, error) {
+	var roots *x509.CertPool
+	if o.caFile != "" {
+		caPEM, err := os.ReadFile(o.caFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read Redis TLS CA file: %w", err)
+		}
+		roots = x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificate found in Redis TLS CA file %s", o.caFile)
+		}
+	}
+
+	config := newTLSConfig(o.serverName, o.sni, roots)
+
+	if o.certFile != "" {
+		cert, err := tls.LoadX509KeyPair(o.certFile, o.keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load Redis TLS client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	if o.insecureSkipVerify {
+		config.InsecureSkipVerify = true //nolint:gosec
+		config.VerifyConnection = nil
+	}
+
+	return config, nil
+}
+
+// newTLSConfig builds the client TLS configuration. The certificate is verified against serverName while sni is
+// sent in the ClientHello, which lets a TLS-terminating proxy route by a name other than the certificate's.
+// A nil roots pool uses the system roots.
+func newTLSConfig(serverName, sni string, roots *x509.CertPool) *tls.Config {
+	if sni == "" || sni == serverName {
+		return &tls.Config{
+			ServerName: serverName,
+			RootCAs:    roots,
+		}
+	}
+
+	return &tls.Config{
+		ServerName: sni,
+		// the default verification checks the certificate against the SNI, VerifyConnection replaces it
+		InsecureSkipVerify: true, //nolint:gosec
+		VerifyConnection: func(state tls.ConnectionState) error {
+			return verifyPeerCertificate(state, serverName, roots)
+		},
+	}
+}
+
+// verifyPeerCertificate verifies the certificate chain presented by the server against serverName
+func verifyPeerCertificate(state tls.ConnectionState, serverName string, roots *x509.CertPool) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("no certificate presented by the Redis server")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       serverName,
+		Roots:         roots,
+		Intermediates: intermediates,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to verify Redis server certificate for %s: %w", serverName, err)
+	}
+	return nil
+}
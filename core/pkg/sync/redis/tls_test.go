@@ -0,0 +1,294 @@
+package redis
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newCapturingTLSServer starts a TLS listener for example.com recording the SNI of each ClientHello
+func newCapturingTLSServer(t *testing.T) (*httptest.Server, *x509.CertPool, <-chan string) {
+	t.Helper()
+
+	sni := make(chan string, 1)
+	server := httptest.NewUnstartedServer(http.NotFoundHandler())
+	server.TLS = &tls.Config{
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			sni <- hello.ServerName
+			return nil, nil
+		},
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+
+	return server, roots, sni
+}
+
+func TestNewTLSConfig_SendsConfiguredSNI(t *testing.T) {
+	tests := []struct {
+		name        string
+		serverName  string
+		sni         string
+		expectedSNI string
+		expectError bool
+	}{
+		{
+			name:        "SNI defaults to the server name",
+			serverName:  "example.com",
+			expectedSNI: "example.com",
+		},
+		{
+			name:        "SNI distinct from the server name",
+			serverName:  "example.com",
+			sni:         "redis.proxy.internal",
+			expectedSNI: "redis.proxy.internal",
+		},
+		{
+			name:        "certificate is still verified against the server name",
+			serverName:  "other.example.org",
+			sni:         "redis.proxy.internal",
+			expectedSNI: "redis.proxy.internal",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, roots, sni := newCapturingTLSServer(t)
+
+			conn, err := tls.Dial("tcp", server.Listener.Addr().String(), newTLSConfig(tt.serverName, tt.sni, roots))
+			if tt.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				_ = conn.Close()
+			}
+
+			assert.Equal(t, tt.expectedSNI, <-sni)
+		})
+	}
+}
+
+func TestNewRedisSync_TLSNames(t *testing.T) {
+	tests := []struct {
+		name               string
+		uri                string
+		expectedAddr       string
+		expectedServerName string
+		expectedSNI        string
+	}{
+		{
+			name:               "defaults to the host",
+			uri:                "rediss://redis.example.com:6380?key=flags",
+			expectedAddr:       "redis.example.com:6380",
+			expectedServerName: "redis.example.com",
+			expectedSNI:        "redis.example.com",
+		},
+		{
+			name:               "IPv4 host",
+			uri:                "rediss://10.0.0.1:6380?key=flags",
+			expectedAddr:       "10.0.0.1:6380",
+			expectedServerName: "10.0.0.1",
+			expectedSNI:        "10.0.0.1",
+		},
+		{
+			name:               "IPv6 host",
+			uri:                "rediss://[::1]:6380?key=flags",
+			expectedAddr:       "[::1]:6380",
+			expectedServerName: "::1",
+			expectedSNI:        "::1",
+		},
+		{
+			name:               "IPv6 host without port",
+			uri:                "rediss://[2001:db8::1]?key=flags",
+			expectedAddr:       "[2001:db8::1]:6379",
+			expectedServerName: "2001:db8::1",
+			expectedSNI:        "2001:db8::1",
+		},
+		{
+			name:               "hostname without port",
+			uri:                "rediss://redis.example.com?key=flags",
+			expectedAddr:       "redis.example.com:6379",
+			expectedServerName: "redis.example.com",
+			expectedSNI:        "redis.example.com",
+		},
+		{
+			name:               "no host",
+			uri:                "rediss:///0?key=flags",
+			expectedAddr:       "localhost:6379",
+			expectedServerName: "localhost",
+			expectedSNI:        "localhost",
+		},
+		{
+			name:               "server name sets the SNI",
+			uri:                "rediss://10.0.0.1:6380?key=flags&tls-server-name=redis.example.com",
+			expectedAddr:       "10.0.0.1:6380",
+			expectedServerName: "redis.example.com",
+			expectedSNI:        "redis.example.com",
+		},
+		{
+			name:               "independent SNI",
+			uri:                "rediss://10.0.0.1:6380?key=flags&tls-server-name=redis.example.com&tls-sni=proxy.example.com",
+			expectedAddr:       "10.0.0.1:6380",
+			expectedServerName: "redis.example.com",
+			expectedSNI:        "proxy.example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, err := NewRedisSync(tt.uri, logger.NewLogger(zap.NewNop(), false))
+			require.NoError(t, err)
+			defer rs.Close()
+
+			assert.Equal(t, tt.expectedServerName, rs.TLSServerName)
+			assert.Equal(t, tt.expectedSNI, rs.TLSSNI)
+
+			options := rs.Client.(goRedisClient).Options()
+			assert.Equal(t, tt.expectedAddr, options.Addr)
+			assert.Equal(t, tt.expectedSNI, options.TLSConfig.ServerName)
+		})
+	}
+}
+
+// writeEphemeralCertificate generates a self-signed certificate, writing it and its key as PEM files into dir
+func writeEphemeralCertificate(t *testing.T, dir string, name string) (*x509.Certificate, string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	return cert, certFile, keyFile
+}
+
+func TestNewRedisSync_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	clientCert, certFile, keyFile := writeEphemeralCertificate(t, dir, "client")
+	caCert, caFile, _ := writeEphemeralCertificate(t, dir, "ca")
+
+	query := url.Values{}
+	query.Set("key", "flags")
+	query.Set("tls_cert", certFile)
+	query.Set("tls_key", keyFile)
+	query.Set("tls_ca", caFile)
+
+	rs, err := NewRedisSync("rediss://redis.example.com:6380?"+query.Encode(), logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+	defer rs.Close()
+
+	assert.Equal(t, certFile, rs.TLSCertFile)
+	assert.Equal(t, keyFile, rs.TLSKeyFile)
+	assert.Equal(t, caFile, rs.TLSCAFile)
+
+	config := rs.Client.(goRedisClient).Options().TLSConfig
+	require.NotNil(t, config)
+	require.Len(t, config.Certificates, 1)
+	assert.Equal(t, clientCert.Raw, config.Certificates[0].Certificate[0])
+
+	expectedRoots := x509.NewCertPool()
+	expectedRoots.AddCert(caCert)
+	assert.True(t, expectedRoots.Equal(config.RootCAs))
+	assert.False(t, config.InsecureSkipVerify)
+}
+
+func TestNewRedisSync_InsecureSkipVerify(t *testing.T) {
+	rs, err := NewRedisSync("rediss://localhost:6380?key=flags&tls_insecure_skip_verify=true",
+		logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+	defer rs.Close()
+
+	assert.True(t, rs.TLSInsecureSkipVerify)
+	assert.True(t, rs.Client.(goRedisClient).Options().TLSConfig.InsecureSkipVerify)
+}
+
+func TestNewRedisSync_TLSFileErrors(t *testing.T) {
+	dir := t.TempDir()
+	_, certFile, keyFile := writeEphemeralCertificate(t, dir, "client")
+	notPEM := filepath.Join(dir, "not-a-certificate")
+	require.NoError(t, os.WriteFile(notPEM, []byte("not a certificate"), 0o600))
+	missing := filepath.Join(dir, "missing.pem")
+
+	tests := []struct {
+		name          string
+		query         map[string]string
+		expectedError string
+	}{
+		{
+			name:          "certificate without key",
+			query:         map[string]string{"tls_cert": certFile},
+			expectedError: "must be set together",
+		},
+		{
+			name:          "missing certificate file",
+			query:         map[string]string{"tls_cert": missing, "tls_key": keyFile},
+			expectedError: "unable to load Redis TLS client certificate",
+		},
+		{
+			name:          "missing CA file",
+			query:         map[string]string{"tls_ca": missing},
+			expectedError: "unable to read Redis TLS CA file",
+		},
+		{
+			name:          "CA file without certificates",
+			query:         map[string]string{"tls_ca": notPEM},
+			expectedError: "no certificate found in Redis TLS CA file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := url.Values{}
+			query.Set("key", "flags")
+			for name, value := range tt.query {
+				query.Set(name, value)
+			}
+
+			_, err := NewRedisSync("rediss://localhost:6380?"+query.Encode(), logger.NewLogger(zap.NewNop(), false))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+}
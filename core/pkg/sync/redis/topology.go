@@ -0,0 +1,203 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// replicaRoutingLatency routes the reads to the closest node of the shard
+	replicaRoutingLatency = "latency"
+	// replicaRoutingRandom routes the reads to a random node of the shard
+	replicaRoutingRandom = "random"
+)
+
+// topology describes the deployment the URI connects to, a single server unless sentinelMaster or cluster is set
+type topology struct {
+	// sentinelMaster names the master monitored by the Sentinels at addrs
+	sentinelMaster string
+	// cluster connects to the cluster seeded by the nodes at addrs
+	cluster bool
+	// addrs are the Sentinel or cluster seed addresses, the URI host first
+	addrs []string
+	// replicaReads routes the reads to the replicas, replicaRouting choosing among the nodes by latency or randomly
+	replicaReads   bool
+	replicaRouting string
+}
+
+// standalone reports whether the URI connects to a single server
+func (t topology) standalone() bool {
+	return t.sentinelMaster == "" && !t.cluster
+}
+
+// parseTopology parses the Sentinel or cluster deployment of the query parameters, address being the dial address of
+// the URI host
+func parseTopology(query url.Values, scheme, address string, database int, modes uriModes) (topology, error) {
+	cluster, err := parseBoolParam(query, "cluster")
+	if err != nil {
+		return topology{}, err
+	}
+	replicaReads, err := parseBoolParam(query, "replica_reads")
+	if err != nil {
+		return topology{}, err
+	}
+	t := topology{
+		sentinelMaster: query.Get("sentinel_master"),
+		cluster:        cluster,
+		replicaReads:   replicaReads,
+		replicaRouting: query.Get("replica_routing"),
+	}
+
+	switch t.replicaRouting {
+	case "", replicaRoutingLatency, replicaRoutingRandom:
+	default:
+		return topology{}, fmt.Errorf("invalid value for query parameter 'replica_routing': %s, expected %s or %s",
+			t.replicaRouting, replicaRoutingLatency, replicaRoutingRandom)
+	}
+	if t.replicaRouting != "" && !t.replicaReads {
+		return topology{}, errors.New("query parameter 'replica_routing' requires 'replica_reads'")
+	}
+
+	if t.standalone() {
+		if len(query["addr"]) > 0 {
+			return topology{}, errors.New("query parameter 'addr' requires 'sentinel_master' or 'cluster'")
+		}
+		if t.replicaReads {
+			return topology{}, errors.New("query parameter 'replica_reads' requires 'sentinel_master' or 'cluster', " +
+				"a single server connection can't route reads to replicas")
+		}
+		return t, nil
+	}
+
+	if t.sentinelMaster != "" && t.cluster {
+		return topology{}, errors.New("query parameters 'sentinel_master' and 'cluster' can't be combined")
+	}
+	if scheme == "unix" {
+		return topology{}, errors.New("a unix URI can't connect to Sentinels or a cluster")
+	}
+	if t.cluster {
+		if database != 0 {
+			return topology{}, fmt.Errorf("a Redis cluster only has database 0, got %d", database)
+		}
+		if query.Get("pattern") != "" {
+			return topology{}, errors.New("query parameter 'pattern' can't be combined with 'cluster', " +
+				"SCAN only covers a single node")
+		}
+		if modes.watch {
+			return topology{}, errors.New("query parameter 'watch' can't be combined with 'cluster', " +
+				"keyspace notifications are only published by the node holding the key")
+		}
+	}
+
+	t.addrs = []string{address}
+	for _, addr := range query["addr"] {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			return topology{}, fmt.Errorf("invalid value for query parameter 'addr': %w", err)
+		}
+		t.addrs = append(t.addrs, addr)
+	}
+	return t, nil
+}
+
+// newClient creates the client of the deployment. A Sentinel client routing the reads by latency or randomly is a
+// cluster client of the master and its replicas, the plain failover client only choosing the master or a replica.
+func (t topology) newClient(opts *redis.Options) hookClient {
+	switch {
+	case t.cluster:
+		return goRedisClusterClient{redis.NewClusterClient(t.clusterOptions(opts))}
+	case t.sentinelMaster != "" && t.replicaRouting != "":
+		return goRedisClusterClient{redis.NewFailoverClusterClient(t.failoverOptions(opts))}
+	case t.sentinelMaster != "":
+		return goRedisClient{redis.NewFailoverClient(t.failoverOptions(opts))}
+	default:
+		return goRedisClient{redis.NewClient(opts)}
+	}
+}
+
+// failoverOptions returns the Sentinel client options with the connection settings of opts
+func (t topology) failoverOptions(opts *redis.Options) *redis.FailoverOptions {
+	return &redis.FailoverOptions{
+		MasterName:      t.sentinelMaster,
+		SentinelAddrs:   t.addrs,
+		ReplicaOnly:     t.replicaReads && t.replicaRouting == "",
+		RouteByLatency:  t.replicaRouting == replicaRoutingLatency,
+		RouteRandomly:   t.replicaRouting == replicaRoutingRandom,
+		Username:        opts.Username,
+		Password:        opts.Password,
+		DB:              opts.DB,
+		Protocol:        opts.Protocol,
+		DialTimeout:     opts.DialTimeout,
+		ReadTimeout:     opts.ReadTimeout,
+		WriteTimeout:    opts.WriteTimeout,
+		PoolSize:        opts.PoolSize,
+		MinIdleConns:    opts.MinIdleConns,
+		PoolTimeout:     opts.PoolTimeout,
+		ConnMaxIdleTime: opts.ConnMaxIdleTime,
+		ConnMaxLifetime: opts.ConnMaxLifetime,
+		TLSConfig:       opts.TLSConfig,
+	}
+}
+
+// clusterOptions returns the cluster client options with the connection settings of opts
+func (t topology) clusterOptions(opts *redis.Options) *redis.ClusterOptions {
+	return &redis.ClusterOptions{
+		Addrs:           t.addrs,
+		ReadOnly:        t.replicaReads,
+		RouteByLatency:  t.replicaRouting == replicaRoutingLatency,
+		RouteRandomly:   t.replicaRouting == replicaRoutingRandom,
+		Username:        opts.Username,
+		Password:        opts.Password,
+		Protocol:        opts.Protocol,
+		DialTimeout:     opts.DialTimeout,
+		ReadTimeout:     opts.ReadTimeout,
+		WriteTimeout:    opts.WriteTimeout,
+		PoolSize:        opts.PoolSize,
+		MinIdleConns:    opts.MinIdleConns,
+		PoolTimeout:     opts.PoolTimeout,
+		ConnMaxIdleTime: opts.ConnMaxIdleTime,
+		ConnMaxLifetime: opts.ConnMaxLifetime,
+		TLSConfig:       opts.TLSConfig,
+	}
+}
+
+// hookClient is a go-redis client adapted to RedisClient that the audit and read-only hooks can be added to
+type hookClient interface {
+	RedisClient
+	AddHook(hook redis.Hook)
+}
+
+// goRedisClusterClient adapts a go-redis cluster client to the RedisClient interface
+type goRedisClusterClient struct {
+	*redis.ClusterClient
+}
+
+func (c goRedisClusterClient) Subscribe(ctx context.Context, channels ...string) PubSub {
+	return c.ClusterClient.Subscribe(ctx, channels...)
+}
+
+// ModuleList lists the modules loaded by the node serving the command
+func (c goRedisClusterClient) ModuleList(ctx context.Context) *redis.SliceCmd {
+	cmd := redis.NewSliceCmd(ctx, "module", "list")
+	_ = c.Process(ctx, cmd)
+	return cmd
+}
+
+// setClientOptions applies set to the options of the client built from the URI. A Sentinel or cluster client copies
+// them when created and is rebuilt, a single server client sharing them. It has no effect on a client passed to
+// NewRedisSyncWithClient.
+func (rs *Sync) setClientOptions(set func(opts *redis.Options)) {
+	if rs.clientOptions == nil {
+		return
+	}
+
+	set(rs.clientOptions)
+	if !rs.topology.standalone() {
+		_ = rs.Client.Close()
+		rs.Client = rs.newClient()
+	}
+}
@@ -0,0 +1,220 @@
+package redis
+
+import (
+	"crypto/tls"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRedisSync_SentinelReplicaReads(t *testing.T) {
+	tests := []struct {
+		name           string
+		uri            string
+		expectCluster  bool
+		replicaOnly    bool
+		routeByLatency bool
+		routeRandomly  bool
+	}{
+		{
+			name: "master",
+			uri:  "redis://:secret@sentinel-1:26379/2?key=flags&sentinel_master=mymaster&addr=sentinel-2:26379",
+		},
+		{
+			name: "replicas",
+			uri: "redis://:secret@sentinel-1:26379/2?key=flags&sentinel_master=mymaster&addr=sentinel-2:26379" +
+				"&replica_reads=true",
+			replicaOnly: true,
+		},
+		{
+			name: "replicas by latency",
+			uri: "redis://:secret@sentinel-1:26379/2?key=flags&sentinel_master=mymaster&addr=sentinel-2:26379" +
+				"&replica_reads=true&replica_routing=latency",
+			expectCluster:  true,
+			routeByLatency: true,
+		},
+		{
+			name: "replicas randomly",
+			uri: "redis://:secret@sentinel-1:26379/2?key=flags&sentinel_master=mymaster&addr=sentinel-2:26379" +
+				"&replica_reads=true&replica_routing=random",
+			expectCluster: true,
+			routeRandomly: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, err := NewRedisSync(tt.uri, logger.NewLogger(zap.NewNop(), false))
+			require.NoError(t, err)
+			defer rs.Close()
+
+			opts := rs.topology.failoverOptions(rs.clientOptions)
+			assert.Equal(t, "mymaster", opts.MasterName)
+			assert.Equal(t, []string{"sentinel-1:26379", "sentinel-2:26379"}, opts.SentinelAddrs)
+			assert.Equal(t, "secret", opts.Password)
+			assert.Equal(t, 2, opts.DB)
+			assert.Equal(t, tt.replicaOnly, opts.ReplicaOnly)
+			assert.Equal(t, tt.routeByLatency, opts.RouteByLatency)
+			assert.Equal(t, tt.routeRandomly, opts.RouteRandomly)
+
+			if tt.expectCluster {
+				client, ok := rs.Client.(goRedisClusterClient)
+				require.True(t, ok)
+				assert.Equal(t, tt.routeByLatency, client.Options().RouteByLatency)
+				assert.Equal(t, tt.routeRandomly, client.Options().RouteRandomly)
+			} else {
+				_, ok := rs.Client.(goRedisClient)
+				assert.True(t, ok)
+			}
+		})
+	}
+}
+
+func TestNewRedisSync_ClusterReplicaReads(t *testing.T) {
+	tests := []struct {
+		name           string
+		uri            string
+		readOnly       bool
+		routeByLatency bool
+		routeRandomly  bool
+	}{
+		{
+			name: "masters",
+			uri:  "redis://node-1:7000?key=flags&cluster=true&addr=node-2:7000",
+		},
+		{
+			name:     "replicas",
+			uri:      "redis://node-1:7000?key=flags&cluster=true&addr=node-2:7000&replica_reads=true",
+			readOnly: true,
+		},
+		{
+			name: "replicas by latency",
+			uri: "redis://node-1:7000?key=flags&cluster=true&addr=node-2:7000&replica_reads=true" +
+				"&replica_routing=latency",
+			readOnly:       true,
+			routeByLatency: true,
+		},
+		{
+			name: "replicas randomly",
+			uri: "redis://node-1:7000?key=flags&cluster=true&addr=node-2:7000&replica_reads=true" +
+				"&replica_routing=random",
+			readOnly:      true,
+			routeRandomly: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs, err := NewRedisSync(tt.uri, logger.NewLogger(zap.NewNop(), false))
+			require.NoError(t, err)
+			defer rs.Close()
+
+			client, ok := rs.Client.(goRedisClusterClient)
+			require.True(t, ok)
+			opts := client.Options()
+			assert.Equal(t, []string{"node-1:7000", "node-2:7000"}, opts.Addrs)
+			assert.Equal(t, tt.readOnly, opts.ReadOnly)
+			assert.Equal(t, tt.routeByLatency, opts.RouteByLatency)
+			assert.Equal(t, tt.routeRandomly, opts.RouteRandomly)
+		})
+	}
+}
+
+func TestNewRedisSync_TopologyErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		uri         string
+		expectedErr string
+	}{
+		{
+			name:        "replica reads of a single server",
+			uri:         "redis://localhost:6379/0?key=flags&replica_reads=true",
+			expectedErr: "query parameter 'replica_reads' requires 'sentinel_master' or 'cluster'",
+		},
+		{
+			name:        "invalid replica reads",
+			uri:         "redis://localhost:6379/0?key=flags&replica_reads=replica",
+			expectedErr: "invalid value for query parameter 'replica_reads'",
+		},
+		{
+			name:        "routing without replica reads",
+			uri:         "redis://localhost:6379/0?key=flags&cluster=true&replica_routing=latency",
+			expectedErr: "query parameter 'replica_routing' requires 'replica_reads'",
+		},
+		{
+			name:        "unknown routing",
+			uri:         "redis://localhost:6379/0?key=flags&cluster=true&replica_reads=true&replica_routing=nearest",
+			expectedErr: "invalid value for query parameter 'replica_routing'",
+		},
+		{
+			name:        "seed address of a single server",
+			uri:         "redis://localhost:6379/0?key=flags&addr=localhost:6380",
+			expectedErr: "query parameter 'addr' requires 'sentinel_master' or 'cluster'",
+		},
+		{
+			name:        "invalid seed address",
+			uri:         "redis://localhost:6379/0?key=flags&cluster=true&addr=localhost",
+			expectedErr: "invalid value for query parameter 'addr'",
+		},
+		{
+			name:        "Sentinel cluster",
+			uri:         "redis://localhost:26379/0?key=flags&sentinel_master=mymaster&cluster=true",
+			expectedErr: "can't be combined",
+		},
+		{
+			name:        "unix socket",
+			uri:         "unix:///tmp/redis.sock?key=flags&sentinel_master=mymaster",
+			expectedErr: "a unix URI can't connect to Sentinels or a cluster",
+		},
+		{
+			name:        "cluster database",
+			uri:         "redis://localhost:7000/1?key=flags&cluster=true",
+			expectedErr: "a Redis cluster only has database 0",
+		},
+		{
+			name:        "cluster pattern",
+			uri:         "redis://localhost:7000?pattern=flags:*&cluster=true",
+			expectedErr: "query parameter 'pattern' can't be combined with 'cluster'",
+		},
+		{
+			name:        "cluster watch",
+			uri:         "redis://localhost:7000?key=flags&cluster=true&watch=true",
+			expectedErr: "query parameter 'watch' can't be combined with 'cluster'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRedisSync(tt.uri, logger.NewLogger(zap.NewNop(), false))
+			require.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestRedisSync_SetClientOptionsRebuildsCluster(t *testing.T) {
+	rs, err := NewRedisSync("redis://node-1:7000?key=flags&cluster=true&replica_reads=true",
+		logger.NewLogger(zap.NewNop(), false), WithTLSConfig(&tls.Config{ServerName: "node-1"}))
+	require.NoError(t, err)
+	defer rs.Close()
+
+	opts := rs.Client.(goRedisClusterClient).Options()
+	require.NotNil(t, opts.TLSConfig)
+	assert.Equal(t, "node-1", opts.TLSConfig.ServerName)
+	assert.True(t, opts.ReadOnly)
+
+	require.ErrorContains(t, rs.SetDatabase(1), "a Redis cluster only has database 0")
+}
+
+func TestRedisSync_SetDatabaseSentinel(t *testing.T) {
+	rs, err := NewRedisSync("redis://sentinel-1:26379?key=flags&sentinel_master=mymaster&replica_reads=true",
+		logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+	defer rs.Close()
+
+	require.NoError(t, rs.SetDatabase(3))
+	assert.Equal(t, 3, rs.Client.(goRedisClient).Options().DB)
+	assert.True(t, rs.topology.failoverOptions(rs.clientOptions).ReplicaOnly)
+}
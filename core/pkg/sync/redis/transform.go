@@ -0,0 +1,16 @@
+package redis
+
+import "fmt"
+
+// transformValue returns the raw value of key, as fetched and decoded, passed through TransformFunc and with its
+// environment variables expanded, before it is converted to JSON
+func (rs *Sync) transformValue(key, value string) (string, error) {
+	if rs.TransformFunc != nil && value != "" {
+		transformed, err := rs.TransformFunc([]byte(value))
+		if err != nil {
+			return "", fmt.Errorf("failed to transform the value of Redis key %s: %w", key, err)
+		}
+		value = string(transformed)
+	}
+	return rs.expandValueEnv(key, value)
+}
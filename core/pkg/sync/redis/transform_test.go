@@ -0,0 +1,103 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// unwrapConfig unwraps the configuration of a {"config": {...}} envelope
+func unwrapConfig(value []byte) ([]byte, error) {
+	var envelope struct {
+		Config json.RawMessage `json:"config"`
+	}
+	if err := json.Unmarshal(value, &envelope); err != nil {
+		return nil, err
+	}
+	if envelope.Config == nil {
+		return nil, errors.New("missing config envelope")
+	}
+	return envelope.Config, nil
+}
+
+func TestWithTransformFunc(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags", log)
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.Nil(t, rs.TransformFunc)
+
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags", log, WithTransformFunc(unwrapConfig))
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.NotNil(t, rs.TransformFunc)
+
+	_, err = NewRedisSync("redis://localhost:6379/0?key=flags", log, WithTransformFunc(nil))
+	require.Error(t, err)
+}
+
+func TestRedisSync_fetchDataTransformsValues(t *testing.T) {
+	configuration := `{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+
+	tests := []struct {
+		name      string
+		transform func([]byte) ([]byte, error)
+		value     string
+		expected  string
+		expectErr string
+	}{
+		{
+			name:     "identity by default",
+			value:    configuration,
+			expected: configuration,
+		},
+		{
+			name:      "envelope unwrapped",
+			transform: unwrapConfig,
+			value:     `{"version":3,"config":` + configuration + `}`,
+			expected:  configuration,
+		},
+		{
+			name:      "transform failure",
+			transform: unwrapConfig,
+			value:     configuration,
+			expectErr: "failed to transform the value of Redis key test-key: missing config envelope",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, skipJSONModule := range []bool{false, true} {
+				mockClient := &MockRedisClient{}
+				mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(tt.value))
+				mockClient.On("Get", mock.Anything, "test-key").Return(redis.NewStringResult(tt.value, nil))
+
+				rs := &Sync{
+					Client:         mockClient,
+					Logger:         logger.NewLogger(zap.NewNop(), false),
+					Key:            "test-key",
+					SkipJSONModule: skipJSONModule,
+					TransformFunc:  tt.transform,
+				}
+
+				data, err := rs.fetchData(context.Background())
+				if tt.expectErr != "" {
+					require.ErrorContains(t, err, tt.expectErr)
+					continue
+				}
+				require.NoError(t, err)
+				assert.JSONEq(t, tt.expected, data)
+				assert.Equal(t, rs.generateSHA([]byte(data)), rs.currentSHA())
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package redis
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/open-feature/flagd/core/pkg/model"
+)
+
+// ErrInvalidConfiguration is returned when validation is enabled and the fetched document isn't a flag configuration
+var ErrInvalidConfiguration = errors.New("invalid flag configuration")
+
+// schemaStrict is the schema query parameter value rejecting configurations that don't conform to the flag schema
+const schemaStrict = "strict"
+
+// parseSchemaValidation validates the schema query parameter, empty leaving schema violations to be logged only
+func parseSchemaValidation(value string) (string, error) {
+	switch strings.ToLower(value) {
+	case "":
+		return "", nil
+	case schemaStrict:
+		return schemaStrict, nil
+	default:
+		return "", fmt.Errorf("unsupported value for query parameter 'schema': %s", value)
+	}
+}
+
+// parseMinFlags validates the min_flags query parameter, zero disabling the guard
+func parseMinFlags(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	minFlags, err := strconv.Atoi(value)
+	if err != nil || minFlags < 0 {
+		return 0, fmt.Errorf("invalid value for query parameter 'min_flags': %s", value)
+	}
+	return minFlags, nil
+}
+
+// StrictSchema reports whether configurations that don't conform to the flagd flag schema must be rejected. The
+// schema is checked by the consumer of the configuration, such as the standalone service.
+func (rs *Sync) StrictSchema() bool {
+	return rs.SchemaValidation == schemaStrict
+}
+
+// validateConfiguration checks that data parses as a flag configuration with well-formed flag definitions
+func validateConfiguration(data string) error {
+	var document struct {
+		Flags map[string]json.RawMessage `json:"flags"`
+	}
+	if err := json.Unmarshal([]byte(data), &document); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidConfiguration, err)
+	}
+	if document.Flags == nil {
+		return fmt.Errorf("%w: missing 'flags' object", ErrInvalidConfiguration)
+	}
+
+	for key, raw := range document.Flags {
+		var flag model.Flag
+		if err := json.Unmarshal(raw, &flag); err != nil {
+			return fmt.Errorf("%w: flag '%s': %w", ErrInvalidConfiguration, key, err)
+		}
+		if len(flag.Variants) == 0 {
+			return fmt.Errorf("%w: flag '%s' has no variants", ErrInvalidConfiguration, key)
+		}
+		if _, ok := flag.Variants[flag.DefaultVariant]; flag.DefaultVariant != "" && !ok {
+			return fmt.Errorf("%w: flag '%s' has unknown defaultVariant '%s'", ErrInvalidConfiguration, key,
+				flag.DefaultVariant)
+		}
+	}
+
+	return nil
+}
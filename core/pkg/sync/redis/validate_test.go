@@ -0,0 +1,158 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestValidateConfiguration(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		expectError bool
+	}{
+		{
+			name: "valid configuration",
+			data: `{"flags":{"test":{"state":"ENABLED","variants":{"on":true,"off":false},"defaultVariant":"on"}}}`,
+		},
+		{
+			name:        "malformed JSON",
+			data:        `{"flags":{"test":`,
+			expectError: true,
+		},
+		{
+			name:        "missing flags",
+			data:        `{"test":{"state":"ENABLED"}}`,
+			expectError: true,
+		},
+		{
+			name:        "malformed flag",
+			data:        `{"flags":{"test":{"state":true}}}`,
+			expectError: true,
+		},
+		{
+			name:        "no variants",
+			data:        `{"flags":{"test":{"state":"ENABLED"}}}`,
+			expectError: true,
+		},
+		{
+			name:        "unknown default variant",
+			data:        `{"flags":{"test":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"off"}}}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateConfiguration(tt.data)
+			if tt.expectError {
+				assert.ErrorIs(t, err, ErrInvalidConfiguration)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRedisSync_ValidateKeepsLastKnownGood(t *testing.T) {
+	valid := `{"flags":{"test":{"state":"ENABLED","variants":{"on":true,"off":false},"defaultVariant":"on"}}}`
+	invalid := `{"flags":{"test":{"state":"ENABLED","variants":`
+	fixed := `{"flags":{"test":{"state":"DISABLED","variants":{"on":true,"off":false},"defaultVariant":"on"}}}`
+
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(valid)).Once()
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(invalid)).Once()
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(fixed)).Once()
+
+	rs := &Sync{
+		URI:      "redis://localhost:6379/0?key=test-key&validate=true",
+		Client:   mockClient,
+		Logger:   logger.NewLogger(zap.NewNop(), false),
+		Key:      "test-key",
+		Validate: true,
+		metrics:  newMetrics(),
+	}
+	dataSync := make(chan sync.DataSync, 3)
+
+	rs.poll(context.Background(), dataSync)
+	require.Len(t, dataSync, 1)
+	assert.Equal(t, valid, (<-dataSync).FlagData)
+	knownGoodSHA := rs.LastSHA
+
+	// the invalid document is neither emitted nor remembered
+	rs.poll(context.Background(), dataSync)
+	assert.Empty(t, dataSync)
+	assert.Equal(t, knownGoodSHA, rs.LastSHA)
+	assert.InDelta(t, 1, testutil.ToFloat64(rs.metrics.invalidConfigs), 0)
+
+	rs.poll(context.Background(), dataSync)
+	require.Len(t, dataSync, 1)
+	assert.Equal(t, fixed, (<-dataSync).FlagData)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_ValidateRejectsInitialInvalidDocument(t *testing.T) {
+	mockClient := &MockRedisClient{}
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(`not json`))
+	mockClient.On("Close").Return(nil)
+
+	mockCron := &MockCron{}
+	mockCron.On("AddFunc", mock.Anything, mock.Anything).Return(nil)
+
+	rs := &Sync{
+		Client:   mockClient,
+		Cron:     mockCron,
+		Logger:   logger.NewLogger(zap.NewNop(), false),
+		Key:      "test-key",
+		Interval: 30,
+		Validate: true,
+	}
+	dataSync := make(chan sync.DataSync, 1)
+
+	err := rs.Sync(context.Background(), dataSync)
+	require.ErrorIs(t, err, ErrInvalidConfiguration)
+	assert.Empty(t, dataSync)
+	assert.False(t, rs.IsReady())
+}
+
+func TestNewRedisSync_SchemaValidation(t *testing.T) {
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags", logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.False(t, rs.StrictSchema())
+
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags&schema=strict", logger.NewLogger(zap.NewNop(), false))
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.True(t, rs.StrictSchema())
+
+	_, err = NewRedisSync("redis://localhost:6379/0?key=flags&schema=lenient", logger.NewLogger(zap.NewNop(), false))
+	require.ErrorContains(t, err, "schema")
+}
+
+func TestNewRedisSync_MinFlags(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379/0?key=flags", log)
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.Zero(t, rs.MinFlags)
+
+	rs, err = NewRedisSync("redis://localhost:6379/0?key=flags&min_flags=10", log)
+	require.NoError(t, err)
+	defer rs.Close()
+	assert.Equal(t, 10, rs.MinFlags)
+
+	for _, value := range []string{"-1", "many"} {
+		_, err = NewRedisSync("redis://localhost:6379/0?key=flags&min_flags="+value, log)
+		require.ErrorContains(t, err, "min_flags")
+	}
+}
@@ -0,0 +1,126 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"go.uber.org/zap"
+)
+
+const notifyKeyspaceEvents = "notify-keyspace-events"
+
+// keyspaceEventsAll enables keyspace and keyevent notifications of every event class
+const keyspaceEventsAll = "KEA"
+
+// keyspaceChannels returns the pub/sub channels carrying keyspace notifications of the synced keys and of the
+// metadata key
+func (rs *Sync) keyspaceChannels() []string {
+	keys := rs.syncedKeys()
+	if rs.MetadataKey != "" {
+		keys = append(slices.Clone(keys), rs.MetadataKey)
+	}
+	channels := make([]string, len(keys))
+	for i, key := range keys {
+		channels[i] = fmt.Sprintf("__keyspace@%d__:%s", rs.Database, key)
+	}
+	return channels
+}
+
+// subscribeKeyspace subscribes to keyspace notifications of the synced key. It returns nil when notifications are
+// unavailable, in which case the caller falls back to polling.
+func (rs *Sync) subscribeKeyspace(ctx context.Context) PubSub {
+	enabled, err := rs.keyspaceNotificationsEnabled(ctx)
+	switch {
+	case err != nil:
+		// CONFIG is commonly restricted on managed servers, notifications may still be configured
+		rs.Logger.Warn("unable to verify "+notifyKeyspaceEvents+", watching anyway", rs.logFields(zap.Error(err))...)
+	case !enabled:
+		rs.Logger.Warn(notifyKeyspaceEvents+" is not configured on the Redis server, falling back to polling",
+			rs.logFields()...)
+		return nil
+	}
+
+	pubsub := rs.client().Subscribe(ctx, rs.keyspaceChannels()...)
+
+	// Wait for the subscription to be confirmed before relying on it
+	if _, err := pubsub.Receive(ctx); err != nil {
+		rs.Logger.Warn("unable to subscribe to keyspace notifications, falling back to polling",
+			rs.logFields(zap.Error(err))...)
+		_ = pubsub.Close()
+		return nil
+	}
+
+	return pubsub
+}
+
+// keyspaceNotificationsEnabled reports whether the server publishes keyspace events for the commands writing the
+// synced type
+func (rs *Sync) keyspaceNotificationsEnabled(ctx context.Context) (bool, error) {
+	config, err := rs.client().ConfigGet(ctx, notifyKeyspaceEvents).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", notifyKeyspaceEvents, err)
+	}
+
+	flags := config[notifyKeyspaceEvents]
+	return strings.Contains(flags, "K") && strings.ContainsAny(flags, rs.keyspaceEventClasses()), nil
+}
+
+// keyspaceEventClasses returns the notify-keyspace-events classes publishing the writes of the synced type, A
+// standing for all of them. A document is written with SET, or JSON.SET publishing a module event.
+func (rs *Sync) keyspaceEventClasses() string {
+	switch rs.Type {
+	case typeHash:
+		return "Ah"
+	case typeList:
+		return "Al"
+	case typeStream:
+		return "At"
+	default:
+		if rs.SkipJSONModule {
+			return "A$"
+		}
+		return "A$d"
+	}
+}
+
+// configureKeyspaceNotifications enables keyspace notifications on the server and verifies they took effect
+func (rs *Sync) configureKeyspaceNotifications(ctx context.Context) error {
+	if err := rs.client().ConfigSet(ctx, notifyKeyspaceEvents, keyspaceEventsAll).Err(); err != nil {
+		return fmt.Errorf("failed to configure %s, the server refused CONFIG SET: %w", notifyKeyspaceEvents, err)
+	}
+
+	enabled, err := rs.keyspaceNotificationsEnabled(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to verify %s: %w", notifyKeyspaceEvents, err)
+	}
+	if !enabled {
+		return fmt.Errorf("%s is not in effect after CONFIG SET", notifyKeyspaceEvents)
+	}
+
+	rs.Logger.Info("configured "+notifyKeyspaceEvents+" on the Redis server",
+		rs.logFields(zap.String("value", keyspaceEventsAll))...)
+	return nil
+}
+
+// watch fetches and emits the configuration whenever a keyspace notification arrives, until ctx is cancelled
+func (rs *Sync) watch(ctx context.Context, pubsub PubSub, dataSync chan<- sync.DataSync) error {
+	messages := pubsub.Channel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-messages:
+			if !ok {
+				return errors.New("keyspace notification subscription closed")
+			}
+
+			rs.Logger.Debug("received keyspace event for Redis key", rs.logFields(zap.String("event", msg.Payload))...)
+			rs.poll(ctx, dataSync)
+		}
+	}
+}
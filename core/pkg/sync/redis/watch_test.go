@@ -0,0 +1,266 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func configGetCmd(value string) *redis.MapStringStringCmd {
+	cmd := redis.NewMapStringStringCmd(context.Background())
+	cmd.SetVal(map[string]string{notifyKeyspaceEvents: value})
+	return cmd
+}
+
+func jsonCmd(value string) *redis.JSONCmd {
+	cmd := &redis.JSONCmd{}
+	cmd.SetVal(value)
+	return cmd
+}
+
+func TestRedisSync_WatchEmitsOnKeyspaceNotification(t *testing.T) {
+	initial := `{"flags":{"test":{"state":"ENABLED"}}}`
+	updated := `{"flags":{"test":{"state":"DISABLED"}}}`
+
+	pubsub := NewMockPubSub()
+	mockClient := &MockRedisClient{}
+	mockClient.On("ConfigGet", mock.Anything, notifyKeyspaceEvents).Return(configGetCmd("KEA"))
+	mockClient.On("Subscribe", mock.Anything, []string{"__keyspace@2__:test-key"}).Return(pubsub)
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(initial)).Once()
+	mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(updated))
+	mockClient.On("Close").Return(nil)
+
+	mockCron := &MockCron{}
+	rs := &Sync{
+		URI:       "redis://localhost:6379/2?key=test-key&watch=true",
+		Client:    mockClient,
+		Cron:      mockCron,
+		Logger:    logger.NewLogger(zap.NewNop(), false),
+		Key:       "test-key",
+		Database:  2,
+		Interval:  30,
+		WatchMode: true,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dataSync := make(chan sync.DataSync, 1)
+	done := make(chan error)
+	go func() {
+		done <- rs.Sync(ctx, dataSync)
+	}()
+
+	assert.Equal(t, initial, (<-dataSync).FlagData)
+
+	pubsub.Publish("__keyspace@2__:test-key", "set")
+	select {
+	case data := <-dataSync:
+		assert.Equal(t, updated, data.FlagData)
+	case <-time.After(time.Second):
+		t.Fatal("no data emitted after keyspace notification")
+	}
+
+	cancel()
+	require.NoError(t, <-done)
+	assert.True(t, pubsub.closed)
+
+	// polling is never scheduled in watch mode
+	mockCron.AssertNotCalled(t, "AddFunc", mock.Anything, mock.Anything)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRedisSync_WatchFallsBackToPolling(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(*MockRedisClient)
+	}{
+		{
+			name: "notifications not configured",
+			setupMock: func(m *MockRedisClient) {
+				m.On("ConfigGet", mock.Anything, notifyKeyspaceEvents).Return(configGetCmd(""))
+			},
+		},
+		{
+			name: "subscription fails",
+			setupMock: func(m *MockRedisClient) {
+				pubsub := NewMockPubSub()
+				pubsub.receiveErr = errors.New("connection reset")
+				m.On("ConfigGet", mock.Anything, notifyKeyspaceEvents).Return(configGetCmd("Kg$"))
+				m.On("Subscribe", mock.Anything, mock.Anything).Return(pubsub)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockRedisClient{}
+			tt.setupMock(mockClient)
+			mockClient.On("JSONGet", mock.Anything, "test-key", mock.Anything).Return(jsonCmd(`{"flags":{}}`))
+			mockClient.On("Close").Return(nil)
+
+			mockCron := &MockCron{}
+			mockCron.On("AddFunc", "@every 30s", mock.Anything).Return(nil)
+			mockCron.On("Start").Return()
+			mockCron.On("Stop").Return()
+
+			rs := &Sync{
+				Client:    mockClient,
+				Cron:      mockCron,
+				Logger:    logger.NewLogger(zap.NewNop(), false),
+				Key:       "test-key",
+				Interval:  30,
+				WatchMode: true,
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			dataSync := make(chan sync.DataSync, 1)
+			done := make(chan error)
+			go func() {
+				done <- rs.Sync(ctx, dataSync)
+			}()
+
+			<-dataSync
+			cancel()
+			require.NoError(t, <-done)
+
+			mockCron.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNewRedisSync_WatchMode(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379?key=flags&watch=true", log)
+	require.NoError(t, err)
+	assert.True(t, rs.WatchMode)
+
+	rs, err = NewRedisSync("redis://localhost:6379?key=flags", log)
+	require.NoError(t, err)
+	assert.False(t, rs.WatchMode)
+
+	_, err = NewRedisSync("redis://localhost:6379?key=flags&watch=maybe", log)
+	assert.Error(t, err)
+}
+
+func TestRedisSync_KeyspaceNotificationsEnabled(t *testing.T) {
+	tests := []struct {
+		name           string
+		keyType        string
+		skipJSONModule bool
+		flags          string
+		expected       bool
+	}{
+		{name: "all events", keyType: typeHash, flags: "KEA", expected: true},
+		{name: "hash events", keyType: typeHash, flags: "Kh", expected: true},
+		{name: "string events of a hash", keyType: typeHash, flags: "K$", expected: false},
+		{name: "generic events of a hash", keyType: typeHash, flags: "Kg", expected: false},
+		{name: "list events", keyType: typeList, flags: "Kl", expected: true},
+		{name: "hash events of a list", keyType: typeList, flags: "Kh", expected: false},
+		{name: "stream events", keyType: typeStream, flags: "Kt", expected: true},
+		{name: "string events of a stream", keyType: typeStream, flags: "K$", expected: false},
+		{name: "string events of a document", keyType: typeDocument, flags: "K$", expected: true},
+		{name: "module events of a document", keyType: typeDocument, flags: "Kd", expected: true},
+		{name: "module events without the JSON module", keyType: typeDocument, skipJSONModule: true, flags: "Kd"},
+		{name: "keyevent notifications only", keyType: typeDocument, flags: "E$"},
+		{name: "disabled", keyType: typeDocument, flags: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockRedisClient{}
+			mockClient.On("ConfigGet", mock.Anything, notifyKeyspaceEvents).Return(configGetCmd(tt.flags))
+
+			rs := &Sync{
+				Client:         mockClient,
+				Logger:         logger.NewLogger(zap.NewNop(), false),
+				Key:            "flags",
+				Type:           tt.keyType,
+				SkipJSONModule: tt.skipJSONModule,
+			}
+
+			enabled, err := rs.keyspaceNotificationsEnabled(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, enabled)
+		})
+	}
+}
+
+func TestRedisSync_InitConfiguresKeyspaceNotifications(t *testing.T) {
+	tests := []struct {
+		name        string
+		setup       func(m *MockRedisClient)
+		expectError string
+	}{
+		{
+			name: "configured and verified",
+			setup: func(m *MockRedisClient) {
+				m.On("ConfigSet", mock.Anything, notifyKeyspaceEvents, "KEA").Return(redis.NewStatusResult("OK", nil))
+				m.On("ConfigGet", mock.Anything, notifyKeyspaceEvents).Return(configGetCmd("AKE"))
+			},
+		},
+		{
+			name: "CONFIG refused",
+			setup: func(m *MockRedisClient) {
+				m.On("ConfigSet", mock.Anything, notifyKeyspaceEvents, "KEA").
+					Return(redis.NewStatusResult("", errors.New("NOPERM this user has no permissions to run 'config|set'")))
+			},
+			expectError: "refused CONFIG SET",
+		},
+		{
+			name: "not in effect",
+			setup: func(m *MockRedisClient) {
+				m.On("ConfigSet", mock.Anything, notifyKeyspaceEvents, "KEA").Return(redis.NewStatusResult("OK", nil))
+				m.On("ConfigGet", mock.Anything, notifyKeyspaceEvents).Return(configGetCmd(""))
+			},
+			expectError: "not in effect",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockRedisClient{}
+			mockClient.On("Ping", mock.Anything).Return(redis.NewStatusResult("PONG", nil))
+			restrictedServer(mockClient)
+			tt.setup(mockClient)
+
+			rs := &Sync{
+				Client:                 mockClient,
+				Logger:                 logger.NewLogger(zap.NewNop(), false),
+				Key:                    "flags",
+				WatchMode:              true,
+				ConfigureNotifications: true,
+			}
+
+			err := rs.Init(context.Background())
+			if tt.expectError != "" {
+				require.ErrorContains(t, err, tt.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNewRedisSync_ConfigureNotifications(t *testing.T) {
+	log := logger.NewLogger(zap.NewNop(), false)
+
+	rs, err := NewRedisSync("redis://localhost:6379?key=flags&watch=true&configure_notifications=true", log)
+	require.NoError(t, err)
+	assert.True(t, rs.ConfigureNotifications)
+
+	_, err = NewRedisSync("redis://localhost:6379?key=flags&configure_notifications=true", log)
+	require.ErrorContains(t, err, "requires 'watch'")
+
+	_, err = NewRedisSync("redis://localhost:6379?key=flags&watch=true&read-only=true&configure_notifications=true", log)
+	require.ErrorContains(t, err, "read-only")
+}
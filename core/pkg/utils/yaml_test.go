@@ -37,6 +37,11 @@ func TestYAMLToJSON(t *testing.T) {
 			expected:      `{"arr":[1,2],"bool":true,"num":123,"obj":{"nested":"value"},"str":"hello"}`,
 			expectedError: false,
 		},
+		"large integers": {
+			input:         []byte("int: 9007199254740993\nuint: 18446744073709551615"),
+			expected:      `{"int":9007199254740993,"uint":18446744073709551615}`,
+			expectedError: false,
+		},
 	}
 
 	for name, tt := range tests {
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync/redis"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const redisDumpPrettyFlagName = "pretty"
+
+var redisDumpCmd = &cobra.Command{
+	Use:   "redis-dump",
+	Short: "Print the flag configuration loaded from Redis",
+	Long: `Fetch the flag configuration from Redis once and print it to stdout as the JSON
+flagd would load, without starting the long-running service.
+
+Example:
+  flagd redis-dump --redis-uri="redis://localhost:6379/0?key=flags" --pretty`,
+	// keep stdout free of the banner, so that the output can be piped
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// flags are read directly rather than through viper, where redis-uri is bound to the redis-sync command
+		uri, err := cmd.Flags().GetString(redisURIFlagName)
+		if err != nil {
+			return err
+		}
+		pretty, err := cmd.Flags().GetBool(redisDumpPrettyFlagName)
+		if err != nil {
+			return err
+		}
+
+		return dumpRedisConfig(cmd.Context(), cmd.OutOrStdout(), uri, pretty)
+	},
+}
+
+func init() {
+	flags := redisDumpCmd.Flags()
+
+	flags.String(redisURIFlagName, "", "Redis URI (e.g., redis://localhost:6379/0?key=flags)")
+	flags.Bool(redisDumpPrettyFlagName, false, "Pretty-print the JSON configuration")
+
+	_ = redisDumpCmd.MarkFlagRequired(redisURIFlagName)
+
+	rootCmd.AddCommand(redisDumpCmd)
+}
+
+// dumpRedisConfig creates the Redis sync provider of uri and writes its configuration to out
+func dumpRedisConfig(ctx context.Context, out io.Writer, uri string, pretty bool) error {
+	// only warnings and errors are logged, to stderr
+	zapConfig := zap.NewDevelopmentConfig()
+	zapConfig.Level = zap.NewAtomicLevelAt(zapcore.WarnLevel)
+	zapLogger, err := zapConfig.Build()
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+	defer zapLogger.Sync()
+
+	rs, err := redis.NewRedisSync(uri, logger.NewLogger(zapLogger, false))
+	if err != nil {
+		return fmt.Errorf("failed to create Redis sync provider: %w", err)
+	}
+	defer rs.Close()
+
+	return writeRedisConfig(ctx, out, rs, pretty)
+}
+
+// writeRedisConfig fetches the configuration of rs once and writes it to out
+func writeRedisConfig(ctx context.Context, out io.Writer, rs *redis.Sync, pretty bool) error {
+	if err := rs.Init(ctx); err != nil {
+		return err
+	}
+
+	data, err := rs.Fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch the configuration from Redis: %w", err)
+	}
+	if data == "" {
+		return fmt.Errorf("Redis key %s not found or empty", rs.Key)
+	}
+
+	if pretty {
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, []byte(data), "", "  "); err != nil {
+			return fmt.Errorf("failed to pretty-print the configuration: %w", err)
+		}
+		data = indented.String()
+	}
+
+	_, err = fmt.Fprintln(out, data)
+	return err
+}
@@ -0,0 +1,245 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync/redis"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fakeRedisClient serves a fixed document from JSON.GET
+type fakeRedisClient struct {
+	document string
+}
+
+func (c fakeRedisClient) JSONGet(_ context.Context, _ string, _ ...string) *goredis.JSONCmd {
+	cmd := &goredis.JSONCmd{}
+	if c.document == "" {
+		cmd.SetErr(goredis.Nil)
+		return cmd
+	}
+	cmd.SetVal(c.document)
+	return cmd
+}
+
+func (c fakeRedisClient) Get(_ context.Context, _ string) *goredis.StringCmd {
+	if c.document == "" {
+		return goredis.NewStringResult("", goredis.Nil)
+	}
+	return goredis.NewStringResult(c.document, nil)
+}
+
+func (c fakeRedisClient) HGetAll(_ context.Context, _ string) *goredis.MapStringStringCmd {
+	return goredis.NewMapStringStringResult(map[string]string{}, nil)
+}
+
+func (c fakeRedisClient) Ping(_ context.Context) *goredis.StatusCmd {
+	return goredis.NewStatusResult("PONG", nil)
+}
+
+func (c fakeRedisClient) ConfigGet(_ context.Context, _ string) *goredis.MapStringStringCmd {
+	return goredis.NewMapStringStringResult(map[string]string{}, nil)
+}
+
+func (c fakeRedisClient) ConfigSet(_ context.Context, _, _ string) *goredis.StatusCmd {
+	return goredis.NewStatusResult("OK", nil)
+}
+
+func (c fakeRedisClient) Subscribe(_ context.Context, _ ...string) redis.PubSub {
+	return nil
+}
+
+func (c fakeRedisClient) XRead(_ context.Context, _ *goredis.XReadArgs) *goredis.XStreamSliceCmd {
+	return goredis.NewXStreamSliceCmdResult(nil, goredis.Nil)
+}
+
+func (c fakeRedisClient) XRevRangeN(_ context.Context, _, _, _ string, _ int64) *goredis.XMessageSliceCmd {
+	return goredis.NewXMessageSliceCmdResult(nil, nil)
+}
+
+func (c fakeRedisClient) LIndex(_ context.Context, _ string, _ int64) *goredis.StringCmd {
+	return goredis.NewStringResult("", goredis.Nil)
+}
+
+func (c fakeRedisClient) MGet(_ context.Context, keys ...string) *goredis.SliceCmd {
+	values := make([]interface{}, len(keys))
+	for i := range keys {
+		if c.document != "" {
+			values[i] = c.document
+		}
+	}
+	return goredis.NewSliceResult(values, nil)
+}
+
+func (c fakeRedisClient) JSONMGet(_ context.Context, _ string, keys ...string) *goredis.JSONSliceCmd {
+	values := make([]interface{}, len(keys))
+	for i := range keys {
+		if c.document != "" {
+			values[i] = c.document
+		}
+	}
+	cmd := &goredis.JSONSliceCmd{}
+	cmd.SetVal(values)
+	return cmd
+}
+
+func (c fakeRedisClient) Scan(_ context.Context, _ uint64, _ string, _ int64) *goredis.ScanCmd {
+	return goredis.NewScanCmdResult(nil, 0, nil)
+}
+
+func (c fakeRedisClient) TTL(_ context.Context, _ string) *goredis.DurationCmd {
+	// go-redis reports a key without expiry as -1
+	return goredis.NewDurationResult(-1, nil)
+}
+
+func (c fakeRedisClient) Set(_ context.Context, _ string, _ interface{}, _ time.Duration) *goredis.StatusCmd {
+	return goredis.NewStatusResult("OK", nil)
+}
+
+func (c fakeRedisClient) JSONSet(_ context.Context, _, _ string, _ interface{}) *goredis.StatusCmd {
+	return goredis.NewStatusResult("OK", nil)
+}
+
+func (c fakeRedisClient) Info(_ context.Context, _ ...string) *goredis.StringCmd {
+	return goredis.NewStringResult("# Server\r\nredis_version:7.2.4\r\n", nil)
+}
+
+func (c fakeRedisClient) ModuleList(_ context.Context) *goredis.SliceCmd {
+	return goredis.NewSliceResult([]interface{}{}, nil)
+}
+
+func (c fakeRedisClient) Close() error {
+	return nil
+}
+
+func TestWriteRedisConfig(t *testing.T) {
+	document := `{"flags":{"new-ui":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+
+	tests := []struct {
+		name        string
+		document    string
+		pretty      bool
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "compact",
+			document: document,
+			expected: document + "\n",
+		},
+		{
+			name:     "pretty",
+			document: document,
+			pretty:   true,
+			expected: `{
+  "flags": {
+    "new-ui": {
+      "state": "ENABLED",
+      "variants": {
+        "on": true
+      },
+      "defaultVariant": "on"
+    }
+  }
+}
+`,
+		},
+		{
+			name:        "missing key",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := &redis.Sync{
+				URI:    "redis://localhost:6379/0?key=flags",
+				Client: fakeRedisClient{document: tt.document},
+				Logger: logger.NewLogger(zap.NewNop(), false),
+				Key:    "flags",
+			}
+
+			var out bytes.Buffer
+			err := writeRedisConfig(context.Background(), &out, rs, tt.pretty)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Empty(t, out.String())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, out.String())
+		})
+	}
+}
+
+func TestCheckRedisConfig(t *testing.T) {
+	document := `{"flags":{"new-ui":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"on"}}}`
+	noVariants := `{"flags":{"new-ui":{"state":"ENABLED","variants":{},"defaultVariant":"on"}}}`
+
+	tests := []struct {
+		name           string
+		document       string
+		skipJSONModule bool
+		expected       string
+		expectError    bool
+	}{
+		{
+			name:     "valid",
+			document: document,
+			expected: "valid, 1 flags, SHA ",
+		},
+		{
+			name:           "valid without the JSON module",
+			document:       document,
+			skipJSONModule: true,
+			expected:       "JSON module: no",
+		},
+		{
+			name:        "invalid",
+			document:    noVariants,
+			expected:    "invalid: flag configuration rejected: flag 'new-ui': no variants defined",
+			expectError: true,
+		},
+		{
+			name:        "missing key",
+			expected:    "invalid: Redis key flags not found or empty",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := logger.NewLogger(zap.NewNop(), false)
+			rs := &redis.Sync{
+				URI:            "redis://:secret@localhost:6379/0?key=flags",
+				Client:         fakeRedisClient{document: tt.document},
+				Logger:         log,
+				Key:            "flags",
+				SkipJSONModule: tt.skipJSONModule,
+			}
+
+			var out bytes.Buffer
+			err := checkRedisConfig(context.Background(), &out, rs, log)
+			if tt.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Contains(t, out.String(), "SHA "+rs.Stats().LastSHA)
+			}
+			assert.Contains(t, out.String(), tt.expected)
+			assert.True(t, strings.HasPrefix(out.String(), redis.RedactURI(rs.URI)+": "))
+			assert.NotContains(t, out.String(), "secret")
+			if !tt.expectError && !tt.skipJSONModule {
+				assert.Contains(t, out.String(), "JSON module: yes")
+			}
+		})
+	}
+}
@@ -3,11 +3,15 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync/redis"
 	redissync "github.com/open-feature/flagd/flagd/pkg/service/redis-sync"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -16,13 +20,31 @@ import (
 )
 
 const (
-	redisURIFlagName            = "redis-uri"
-	redisIntervalFlagName       = "redis-interval"
-	redisSyncPortFlagName       = "redis-sync-port"
-	redisSyncCertPathFlagName   = "redis-sync-cert-path"
-	redisSyncKeyPathFlagName    = "redis-sync-key-path"
-	redisSyncSocketPathFlagName = "redis-sync-socket-path"
-	redisLogFormatFlagName      = "redis-log-format"
+	redisURIFlagName             = "redis-uri"
+	redisIntervalFlagName        = "redis-interval"
+	redisCronFlagName            = "redis-cron"
+	redisDBFlagName              = "redis-db"
+	redisKeyPrefixFlagName       = "redis-key-prefix"
+	redisUsernameFlagName        = "redis-username"
+	redisPasswordFlagName        = "redis-password"
+	redisPasswordFileFlagName    = "redis-password-file"
+	redisCacheFileFlagName       = "redis-cache-file"
+	redisBaseFileFlagName        = "redis-base-file"
+	redisSyncHostFlagName        = "redis-sync-host"
+	redisSyncPortFlagName        = "redis-sync-port"
+	redisSyncCertPathFlagName    = "redis-sync-cert-path"
+	redisSyncKeyPathFlagName     = "redis-sync-key-path"
+	redisSyncClientCAFlagName    = "redis-sync-client-ca"
+	redisSyncSocketPathFlagName  = "redis-sync-socket-path"
+	redisSyncMaxMsgSizeFlagName  = "redis-sync-max-message-size"
+	redisLogFormatFlagName       = "redis-log-format"
+	redisLogLevelFlagName        = "redis-log-level"
+	redisBatchWindowFlagName     = "redis-batch-window"
+	redisMetricsPortFlagName     = "redis-metrics-port"
+	redisHealthPortFlagName      = "redis-health-port"
+	redisShutdownTimeoutFlagName = "redis-shutdown-timeout"
+	redisAllowWriteFlagName      = "redis-allow-write"
+	redisValidateFlagName        = "validate"
 )
 
 var redisSyncCmd = &cobra.Command{
@@ -38,8 +60,19 @@ Example:
 This will:
 1. Poll Redis every 30 seconds (configurable) for flag configurations
 2. Expose a gRPC sync service on port 8016
-3. Allow flagd instances to connect via: --sources='[{"uri":"localhost:8016","provider":"grpc"}]'`,
+3. Allow flagd instances to connect via: --sources='[{"uri":"localhost:8016","provider":"grpc"}]'
+
+With --validate, the configuration is fetched once from every URI and validated instead, exiting non-zero when any
+fails, e.g. to check a new Redis source in CI.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		// a mode of the command rather than configuration, so it's read directly rather than through viper
+		validate, err := cmd.Flags().GetBool(redisValidateFlagName)
+		if err != nil {
+			return err
+		}
+		if validate {
+			return validateRedisSyncConfig(cmd.Context(), cmd.OutOrStdout())
+		}
 		return startRedisSyncService()
 	},
 }
@@ -48,36 +81,98 @@ func init() {
 	flags := redisSyncCmd.Flags()
 
 	// Redis connection flags
-	flags.String(redisURIFlagName, "", "Redis URI (e.g., redis://localhost:6379/0?key=flags)")
+	flags.StringArray(redisURIFlagName, nil,
+		"Redis URI (e.g., redis://localhost:6379/0?key=flags), repeatable with later URIs taking precedence")
 	flags.Uint32(redisIntervalFlagName, 30, "Redis polling interval in seconds")
+	flags.String(redisCronFlagName, "", "Cron expression polling Redis instead of the interval (e.g. \"0 8 * * *\")")
+	flags.Int(redisDBFlagName, -1, "Redis database number, overriding the URI path (-1 keeps the URI database)")
+	flags.String(redisKeyPrefixFlagName, "", "Prefix prepended to every Redis key, overriding the URI prefix")
+	flags.String(redisUsernameFlagName, "", "Redis username, overriding the URI username")
+	flags.String(redisPasswordFlagName, "", "Redis password, overriding the URI password")
+	flags.String(redisPasswordFileFlagName, "", "File containing the Redis password, overriding the URI password")
+	flags.String(redisCacheFileFlagName, "", "File caching the last configuration, served when Redis is down at start")
+	flags.String(redisBaseFileFlagName, "", "Flag file loaded at start, the flags from Redis overriding its flags")
 
 	// gRPC sync service flags
+	flags.String(redisSyncHostFlagName, "", "Host or IP the gRPC sync service binds to (empty binds all interfaces)")
 	flags.Uint16(redisSyncPortFlagName, 8016, "Port for the gRPC sync service")
 	flags.String(redisSyncCertPathFlagName, "", "Path to TLS certificate for gRPC sync service")
 	flags.String(redisSyncKeyPathFlagName, "", "Path to TLS private key for gRPC sync service")
+	flags.String(redisSyncClientCAFlagName, "", "Path to the CA verifying client certificates of gRPC sync service")
 	flags.String(redisSyncSocketPathFlagName, "", "Unix socket path for gRPC sync service")
+	flags.Int(redisSyncMaxMsgSizeFlagName, 0, "Largest gRPC message in bytes of gRPC sync service (0 keeps the defaults)")
+	flags.Duration(redisBatchWindowFlagName, 0, "Window to batch rapid flag updates into a single store update (0 disables batching)")
+
+	// Metrics and health flags
+	flags.Uint16(redisMetricsPortFlagName, 0, "Port serving Prometheus metrics at /metrics (0 disables the endpoint)")
+	flags.Uint16(redisHealthPortFlagName, 0, "Port serving the /healthz and /readyz probes (0 disables the endpoints)")
+	flags.Duration(redisShutdownTimeoutFlagName, 10*time.Second, "Time to wait for the service to stop on shutdown")
+
+	// Management flags
+	flags.Bool(redisAllowWriteFlagName, false, "Allow publishing flag configurations back to Redis")
 
 	// Logging flags
 	flags.String(redisLogFormatFlagName, "console", "Log format (console or json)")
+	flags.String(redisLogLevelFlagName, "info", "Log level (debug, info, warn or error)")
+
+	// Validation flags
+	flags.Bool(redisValidateFlagName, false, "Fetch and validate the configuration once, then exit without serving it")
 
 	// Bind flags to viper
 	_ = viper.BindPFlag(redisURIFlagName, flags.Lookup(redisURIFlagName))
 	_ = viper.BindPFlag(redisIntervalFlagName, flags.Lookup(redisIntervalFlagName))
+	_ = viper.BindPFlag(redisCronFlagName, flags.Lookup(redisCronFlagName))
+	_ = viper.BindPFlag(redisDBFlagName, flags.Lookup(redisDBFlagName))
+	_ = viper.BindPFlag(redisKeyPrefixFlagName, flags.Lookup(redisKeyPrefixFlagName))
+	_ = viper.BindPFlag(redisUsernameFlagName, flags.Lookup(redisUsernameFlagName))
+	_ = viper.BindPFlag(redisPasswordFlagName, flags.Lookup(redisPasswordFlagName))
+	_ = viper.BindPFlag(redisPasswordFileFlagName, flags.Lookup(redisPasswordFileFlagName))
+	_ = viper.BindPFlag(redisCacheFileFlagName, flags.Lookup(redisCacheFileFlagName))
+	_ = viper.BindPFlag(redisBaseFileFlagName, flags.Lookup(redisBaseFileFlagName))
+	_ = viper.BindPFlag(redisSyncHostFlagName, flags.Lookup(redisSyncHostFlagName))
 	_ = viper.BindPFlag(redisSyncPortFlagName, flags.Lookup(redisSyncPortFlagName))
 	_ = viper.BindPFlag(redisSyncCertPathFlagName, flags.Lookup(redisSyncCertPathFlagName))
 	_ = viper.BindPFlag(redisSyncKeyPathFlagName, flags.Lookup(redisSyncKeyPathFlagName))
+	_ = viper.BindPFlag(redisSyncClientCAFlagName, flags.Lookup(redisSyncClientCAFlagName))
 	_ = viper.BindPFlag(redisSyncSocketPathFlagName, flags.Lookup(redisSyncSocketPathFlagName))
+	_ = viper.BindPFlag(redisSyncMaxMsgSizeFlagName, flags.Lookup(redisSyncMaxMsgSizeFlagName))
 	_ = viper.BindPFlag(redisLogFormatFlagName, flags.Lookup(redisLogFormatFlagName))
+	_ = viper.BindPFlag(redisLogLevelFlagName, flags.Lookup(redisLogLevelFlagName))
+	_ = viper.BindPFlag(redisBatchWindowFlagName, flags.Lookup(redisBatchWindowFlagName))
+	_ = viper.BindPFlag(redisMetricsPortFlagName, flags.Lookup(redisMetricsPortFlagName))
+	_ = viper.BindPFlag(redisHealthPortFlagName, flags.Lookup(redisHealthPortFlagName))
+	_ = viper.BindPFlag(redisShutdownTimeoutFlagName, flags.Lookup(redisShutdownTimeoutFlagName))
+	_ = viper.BindPFlag(redisAllowWriteFlagName, flags.Lookup(redisAllowWriteFlagName))
 
 	// Mark required flags
 	_ = redisSyncCmd.MarkFlagRequired(redisURIFlagName)
+	redisSyncCmd.MarkFlagsMutuallyExclusive(redisPasswordFlagName, redisPasswordFileFlagName)
 
 	rootCmd.AddCommand(redisSyncCmd)
 }
 
-func startRedisSyncService() error {
-	// Setup logger
-	logLevel := zapcore.InfoLevel
+// parseLogLevel parses the value of the log level flag
+func parseLogLevel(level string) (zapcore.Level, error) {
+	switch strings.ToLower(level) {
+	case "debug":
+		return zapcore.DebugLevel, nil
+	case "info":
+		return zapcore.InfoLevel, nil
+	case "warn", "warning":
+		return zapcore.WarnLevel, nil
+	case "error":
+		return zapcore.ErrorLevel, nil
+	default:
+		return zapcore.InfoLevel, fmt.Errorf("invalid log level '%s', expected debug, info, warn or error", level)
+	}
+}
+
+// newRedisSyncLogger creates the logger of the log format and level flags
+func newRedisSyncLogger() (*zap.Logger, error) {
+	logLevel, err := parseLogLevel(viper.GetString(redisLogLevelFlagName))
+	if err != nil {
+		return nil, err
+	}
 	logFormat := viper.GetString(redisLogFormatFlagName)
 
 	var zapConfig zap.Config
@@ -91,34 +186,38 @@ func startRedisSyncService() error {
 
 	zapLogger, err := zapConfig.Build()
 	if err != nil {
-		return fmt.Errorf("failed to create logger: %w", err)
+		return nil, fmt.Errorf("failed to create logger: %w", err)
+	}
+	return zapLogger, nil
+}
+
+func startRedisSyncService() error {
+	// Setup logger
+	zapLogger, err := newRedisSyncLogger()
+	if err != nil {
+		return err
 	}
 	defer zapLogger.Sync()
 
 	log := logger.NewLogger(zapLogger, false)
 
 	// Get configuration
-	redisURI := viper.GetString(redisURIFlagName)
-	redisInterval := viper.GetUint32(redisIntervalFlagName)
-	syncPort := viper.GetUint16(redisSyncPortFlagName)
-	certPath := viper.GetString(redisSyncCertPathFlagName)
-	keyPath := viper.GetString(redisSyncKeyPathFlagName)
-	socketPath := viper.GetString(redisSyncSocketPathFlagName)
-
-	log.Info(fmt.Sprintf("Starting Redis sync service with URI: %s", redisURI))
-	log.Info(fmt.Sprintf("Redis polling interval: %d seconds", redisInterval))
-	log.Info(fmt.Sprintf("gRPC sync service port: %d", syncPort))
+	cfg := redisSyncConfig(log)
+	for _, redisURI := range cfg.RedisURIs {
+		log.Info(fmt.Sprintf("Starting Redis sync service with URI: %s", redis.RedactURI(redisURI)))
+	}
+	if cfg.CronSpec != "" {
+		log.Info(fmt.Sprintf("Redis polling schedule: %s", cfg.CronSpec))
+	} else {
+		log.Info(fmt.Sprintf("Redis polling interval: %d seconds", cfg.RedisInterval))
+	}
+	if cfg.SyncHost != "" {
+		log.Info(fmt.Sprintf("gRPC sync service host: %s", cfg.SyncHost))
+	}
+	log.Info(fmt.Sprintf("gRPC sync service port: %d", cfg.SyncPort))
 
 	// Create Redis sync service
-	service, err := redissync.NewService(redissync.Config{
-		RedisURI:      redisURI,
-		RedisInterval: redisInterval,
-		SyncPort:      syncPort,
-		CertPath:      certPath,
-		KeyPath:       keyPath,
-		SocketPath:    socketPath,
-		Logger:        log,
-	})
+	service, err := redissync.NewService(cfg)
 	if err != nil {
 		return fmt.Errorf("failed to create Redis sync service: %w", err)
 	}
@@ -127,6 +226,150 @@ func startRedisSyncService() error {
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
+	// Reload the Redis configuration on SIGHUP and refresh the flags from Redis on SIGUSR1
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP, syscall.SIGUSR1)
+	defer signal.Stop(signals)
+
 	// Start the service
-	return service.Start(ctx)
+	return runRedisSyncService(ctx, service, signals, map[os.Signal]func(ctx context.Context){
+		syscall.SIGHUP: func(ctx context.Context) {
+			log.Info("Reloading the Redis configuration...")
+			if err := reloadRedisSyncConfig(ctx, service, log); err != nil {
+				log.Error(fmt.Sprintf("failed to reload the Redis configuration, keeping the current one: %v", err))
+			}
+		},
+		syscall.SIGUSR1: func(ctx context.Context) {
+			log.Info("Refreshing the flags from Redis...")
+			if err := service.RefreshNow(ctx); err != nil {
+				log.Error(fmt.Sprintf("failed to refresh the flags from Redis: %v", err))
+			}
+		},
+	})
+}
+
+// validateRedisSyncConfig fetches the configuration of every Redis URI once and writes the outcome of its validation
+// to out, failing when any configuration is unreachable or invalid
+func validateRedisSyncConfig(ctx context.Context, out io.Writer) error {
+	zapLogger, err := newRedisSyncLogger()
+	if err != nil {
+		return err
+	}
+	defer zapLogger.Sync()
+
+	log := logger.NewLogger(zapLogger, false)
+	cfg := redisSyncConfig(log)
+
+	failed := 0
+	for _, redisURI := range cfg.RedisURIs {
+		rs, err := redissync.NewProvider(redisURI, cfg)
+		if err != nil {
+			return fmt.Errorf("failed to create Redis sync provider: %w", err)
+		}
+		err = checkRedisConfig(ctx, out, rs, log)
+		_ = rs.Close()
+		if err != nil {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d Redis configurations failed validation", failed, len(cfg.RedisURIs))
+	}
+	return nil
+}
+
+// checkRedisConfig validates the configuration of rs once and writes a summary of the outcome to out
+func checkRedisConfig(ctx context.Context, out io.Writer, rs *redis.Sync, log *logger.Logger) error {
+	result, err := redissync.Check(ctx, rs, log)
+	if err != nil {
+		_, _ = fmt.Fprintf(out, "%s: invalid: %v\n", rs.SourceName(), err)
+		return err
+	}
+
+	jsonModule := "no"
+	if result.JSONModule {
+		jsonModule = "yes"
+	}
+	_, err = fmt.Fprintf(out, "%s: valid, %d flags, SHA %s, JSON module: %s\n",
+		rs.SourceName(), result.Flags, result.SHA, jsonModule)
+	return err
+}
+
+// redisSyncConfig reads the configuration of the Redis sync service from the flags and config file
+func redisSyncConfig(log *logger.Logger) redissync.Config {
+	var database *int
+	if db := viper.GetInt(redisDBFlagName); db >= 0 {
+		database = &db
+	}
+
+	return redissync.Config{
+		RedisURIs:       viper.GetStringSlice(redisURIFlagName),
+		RedisInterval:   viper.GetUint32(redisIntervalFlagName),
+		CronSpec:        viper.GetString(redisCronFlagName),
+		Database:        database,
+		KeyPrefix:       viper.GetString(redisKeyPrefixFlagName),
+		Username:        viper.GetString(redisUsernameFlagName),
+		Password:        viper.GetString(redisPasswordFlagName),
+		PasswordFile:    viper.GetString(redisPasswordFileFlagName),
+		CacheFile:       viper.GetString(redisCacheFileFlagName),
+		BaseFile:        viper.GetString(redisBaseFileFlagName),
+		SyncHost:        viper.GetString(redisSyncHostFlagName),
+		SyncPort:        viper.GetUint16(redisSyncPortFlagName),
+		CertPath:        viper.GetString(redisSyncCertPathFlagName),
+		KeyPath:         viper.GetString(redisSyncKeyPathFlagName),
+		ClientCAPath:    viper.GetString(redisSyncClientCAFlagName),
+		SocketPath:      viper.GetString(redisSyncSocketPathFlagName),
+		MaxMsgSize:      viper.GetInt(redisSyncMaxMsgSizeFlagName),
+		BatchWindow:     viper.GetDuration(redisBatchWindowFlagName),
+		MetricsPort:     viper.GetUint16(redisMetricsPortFlagName),
+		HealthPort:      viper.GetUint16(redisHealthPortFlagName),
+		ShutdownTimeout: viper.GetDuration(redisShutdownTimeoutFlagName),
+		AllowWrite:      viper.GetBool(redisAllowWriteFlagName),
+		Logger:          log,
+	}
+}
+
+// reloadRedisSyncConfig re-reads the config file and applies its Redis configuration to service
+func reloadRedisSyncConfig(ctx context.Context, service *redissync.Service, log *logger.Logger) error {
+	if viper.ConfigFileUsed() != "" {
+		if err := viper.ReadInConfig(); err != nil {
+			return fmt.Errorf("failed to re-read the config file: %w", err)
+		}
+	}
+	return service.Reload(ctx, redisSyncConfig(log))
+}
+
+// runRedisSyncService runs service until ctx is cancelled, then shuts it down within its shutdown timeout. Every
+// signal received on signals is handled by its handler, other signals being ignored.
+func runRedisSyncService(
+	ctx context.Context,
+	service *redissync.Service,
+	signals <-chan os.Signal,
+	handlers map[os.Signal]func(ctx context.Context),
+) error {
+	errs := make(chan error, 1)
+	go func() {
+		errs <- service.Start(ctx)
+	}()
+
+running:
+	for {
+		select {
+		case err := <-errs:
+			return err
+		case sig := <-signals:
+			if handle, ok := handlers[sig]; ok {
+				handle(ctx)
+			}
+		case <-ctx.Done():
+			break running
+		}
+	}
+
+	// Bound the shutdown, rather than waiting on the service indefinitely
+	if err := service.Shutdown(); err != nil {
+		return err
+	}
+	return <-errs
 }
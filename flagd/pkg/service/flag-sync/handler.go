@@ -22,6 +22,8 @@ type syncHandler struct {
 	contextValues       map[string]any
 	deadline            time.Duration
 	disableSyncMetadata bool
+	// streams counts the open SyncFlags streams, nil when they aren't counted
+	streams *streamCounter
 }
 
 func (s syncHandler) SyncFlags(req *syncv1.SyncFlagsRequest, server syncv1grpc.FlagSyncService_SyncFlagsServer) error {
@@ -41,6 +43,8 @@ func (s syncHandler) SyncFlags(req *syncv1.SyncFlagsRequest, server syncv1grpc.F
 	if err != nil {
 		return err
 	}
+	s.streams.open()
+	defer s.streams.close()
 
 	for {
 		select {
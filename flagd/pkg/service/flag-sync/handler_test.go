@@ -106,6 +106,32 @@ func TestSyncHandler_SyncFlags(t *testing.T) {
 	}
 }
 
+func TestSyncHandler_SyncFlagsCountsStreams(t *testing.T) {
+	mp, err := NewMux(store.NewFlags(), nil)
+	require.NoError(t, err)
+
+	handler := syncHandler{
+		mux:     mp,
+		log:     logger.NewLogger(nil, false),
+		streams: &streamCounter{},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockSyncFlagsServer{ctx: ctx, respReady: make(chan struct{}, 1)}
+
+	done := make(chan struct{})
+	go func() {
+		assert.NoError(t, handler.SyncFlags(&syncv1.SyncFlagsRequest{}, stream))
+		close(done)
+	}()
+	require.Eventually(t, func() bool { return handler.streams.count() == 1 }, time.Second, 10*time.Millisecond)
+
+	// the client disconnects
+	cancel()
+	<-done
+	assert.Equal(t, 0, handler.streams.count())
+}
+
 // Mock server for testing
 type mockSyncFlagsServer struct {
 	syncv1grpc.FlagSyncService_SyncFlagsServer
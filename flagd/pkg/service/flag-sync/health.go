@@ -0,0 +1,46 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+
+	"buf.build/gen/go/open-feature/flagd/grpc/go/flagd/sync/v1/syncv1grpc"
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"google.golang.org/grpc/codes"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// healthHandler implements the gRPC health service, reporting the sync service, and the server as a whole, as not
+// serving while the upstream source of the flags fails, so that clients can tell a degraded upstream apart from
+// flags that don't change
+type healthHandler struct {
+	healthv1.UnimplementedHealthServer
+	log *logger.Logger
+	// upstream returns the failure of the upstream source, nil when it is healthy or not reported
+	upstream func() error
+}
+
+func (h healthHandler) Check(_ context.Context, req *healthv1.HealthCheckRequest) (
+	*healthv1.HealthCheckResponse, error,
+) {
+	switch req.GetService() {
+	case "", syncv1grpc.FlagSyncService_ServiceDesc.ServiceName:
+	default:
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("unknown service %s", req.GetService()))
+	}
+
+	if err := h.upstreamError(); err != nil {
+		h.log.Debug(fmt.Sprintf("reporting flag sync service as not serving, upstream degraded: %v", err))
+		return &healthv1.HealthCheckResponse{Status: healthv1.HealthCheckResponse_NOT_SERVING}, nil
+	}
+	return &healthv1.HealthCheckResponse{Status: healthv1.HealthCheckResponse_SERVING}, nil
+}
+
+// upstreamError returns the failure of the upstream source of the flags, nil when it isn't reported
+func (h healthHandler) upstreamError() error {
+	if h.upstream == nil {
+		return nil
+	}
+	return h.upstream()
+}
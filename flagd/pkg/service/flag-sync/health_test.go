@@ -0,0 +1,93 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestSyncServiceHealthReportsUpstreamFailure(t *testing.T) {
+	port := 18029
+	flagStore, sources := getSimpleFlagStore(t)
+
+	var upstreamErr atomic.Pointer[error]
+	service, err := NewSyncService(SvcConfigurations{
+		Logger:  logger.NewLogger(nil, false),
+		Port:    uint16(port),
+		Sources: sources,
+		Store:   flagStore,
+		UpstreamStatus: func() error {
+			if err := upstreamErr.Load(); err != nil {
+				return *err
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating the service: %v", err)
+	}
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelFunc()
+	doneChan := make(chan interface{})
+	go func() {
+		_ = service.Start(ctx)
+		close(doneChan)
+	}()
+	for _, source := range sources {
+		service.Emit(false, source)
+	}
+
+	con, err := grpc.NewClient(fmt.Sprintf("localhost:%d", port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("error creating grpc client: %v", err)
+	}
+	defer con.Close()
+	client := healthv1.NewHealthClient(con)
+
+	check := func(service string) healthv1.HealthCheckResponse_ServingStatus {
+		t.Helper()
+		rsp, err := client.Check(ctx, &healthv1.HealthCheckRequest{Service: service}, grpc.WaitForReady(true))
+		if err != nil {
+			t.Fatalf("error from health check of %q: %v", service, err)
+		}
+		return rsp.GetStatus()
+	}
+
+	if got := check("flagd.sync.v1.FlagSyncService"); got != healthv1.HealthCheckResponse_SERVING {
+		t.Fatalf("expected the sync service to be serving with a healthy upstream, got %s", got)
+	}
+	if service.UpstreamError() != nil {
+		t.Fatalf("expected no upstream error, got %v", service.UpstreamError())
+	}
+
+	failure := errors.New("fetch from Redis failed")
+	upstreamErr.Store(&failure)
+	for _, name := range []string{"", "flagd.sync.v1.FlagSyncService"} {
+		if got := check(name); got != healthv1.HealthCheckResponse_NOT_SERVING {
+			t.Fatalf("expected %q to be not serving with a failed upstream, got %s", name, got)
+		}
+	}
+	if !errors.Is(service.UpstreamError(), failure) {
+		t.Fatalf("expected the upstream error %v, got %v", failure, service.UpstreamError())
+	}
+
+	_, err = client.Check(ctx, &healthv1.HealthCheckRequest{Service: "unknown"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected an unknown service to be not found, got %v", err)
+	}
+
+	cancelFunc()
+	<-doneChan
+}
@@ -156,6 +156,15 @@ func (r *Multiplexer) GetAllFlags(source string) (string, error) {
 	return r.selectorFlags[source], nil
 }
 
+// SetSources replaces the known sources, as when the sources of the store are reconfigured
+func (r *Multiplexer) SetSources(sources []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sources = slices.Clone(sources)
+	return r.reFill()
+}
+
 // SourcesAsMetadata returns all known sources, comma separated to be used as service metadata
 func (r *Multiplexer) SourcesAsMetadata() string {
 	r.mu.RLock()
@@ -201,6 +201,30 @@ func TestGetAllFlags(t *testing.T) {
 	assert.Equal(t, flagConfig, emptyConfigString)
 }
 
+func TestSetSources(t *testing.T) {
+	// given
+	mux, err := NewMux(getSimpleFlagStore(t))
+	if err != nil {
+		t.Fatal("error during flag extraction")
+		return
+	}
+
+	// when - replace the sources
+	if err := mux.SetSources([]string{"A", "D"}); err != nil {
+		t.Fatal("error when setting sources")
+		return
+	}
+
+	// then - removed sources are unknown and added ones are served
+	_, err = mux.GetAllFlags("B")
+	assert.Error(t, err)
+
+	flagConfig, err := mux.GetAllFlags("D")
+	assert.NoError(t, err)
+	assert.Equal(t, emptyConfigString, flagConfig)
+	assert.Equal(t, "A,D", mux.SourcesAsMetadata())
+}
+
 func TestGetAllFlagsMetadata(t *testing.T) {
 	// given
 	mux, err := NewMux(getSimpleFlagStore(t))
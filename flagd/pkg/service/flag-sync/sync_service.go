@@ -3,9 +3,14 @@ package sync
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"net"
+	"os"
 	"slices"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	"buf.build/gen/go/open-feature/flagd/grpc/go/flagd/sync/v1/syncv1grpc"
@@ -14,6 +19,7 @@ import (
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type ISyncService interface {
@@ -26,15 +32,21 @@ type ISyncService interface {
 
 type SvcConfigurations struct {
 	Logger              *logger.Logger
+	Host                string // host or IP address the listener binds to, empty binding all interfaces
 	Port                uint16
 	Sources             []string
 	Store               *store.Store
 	ContextValues       map[string]any
 	CertPath            string
 	KeyPath             string
+	ClientCAPath        string
 	SocketPath          string
+	MaxMsgSize          int
 	StreamDeadline      time.Duration
 	DisableSyncMetadata bool
+	// UpstreamStatus returns the failure of the upstream source of the flags, such as no flags having been loaded from
+	// it yet, reported by the gRPC health service and UpstreamError. Nil reports the upstream as healthy.
+	UpstreamStatus func() error
 }
 
 type Service struct {
@@ -42,11 +54,13 @@ type Service struct {
 	logger   *logger.Logger
 	mux      *Multiplexer
 	server   *grpc.Server
+	streams  *streamCounter
+	health   healthHandler
 
 	startupTracker syncTracker
 }
 
-func loadTLSCredentials(certPath string, keyPath string) (credentials.TransportCredentials, error) {
+func loadTLSCredentials(certPath, keyPath, clientCAPath string) (credentials.TransportCredentials, error) {
 	// Load server's certificate and private key
 	serverCert, err := tls.LoadX509KeyPair(certPath, keyPath)
 	if err != nil {
@@ -60,6 +74,20 @@ func loadTLSCredentials(certPath string, keyPath string) (credentials.TransportC
 		MinVersion:   tls.VersionTLS12,
 	}
 
+	// Require and verify client certificates against the client CA
+	if clientCAPath != "" {
+		pemClientCA, err := os.ReadFile(clientCAPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA from path '%s': %w", clientCAPath, err)
+		}
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pemClientCA) {
+			return nil, fmt.Errorf("no PEM certificate found in client CA '%s'", clientCAPath)
+		}
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+		config.ClientCAs = clientCAs
+	}
+
 	return credentials.NewTLS(config), nil
 }
 
@@ -71,32 +99,43 @@ func NewSyncService(cfg SvcConfigurations) (*Service, error) {
 		return nil, fmt.Errorf("error initializing multiplexer: %w", err)
 	}
 
-	var server *grpc.Server
+	var serverOptions []grpc.ServerOption
+	if cfg.ClientCAPath != "" && (cfg.CertPath == "" || cfg.KeyPath == "") {
+		return nil, errors.New("verifying client certificates requires a TLS certificate and key")
+	}
 	if cfg.CertPath != "" && cfg.KeyPath != "" {
-		tlsCredentials, err := loadTLSCredentials(cfg.CertPath, cfg.KeyPath)
+		tlsCredentials, err := loadTLSCredentials(cfg.CertPath, cfg.KeyPath, cfg.ClientCAPath)
 		if err != nil {
 			return nil, fmt.Errorf("failed to load TLS cert and key: %w", err)
 		}
-		server = grpc.NewServer(grpc.Creds(tlsCredentials))
-	} else {
-		server = grpc.NewServer()
+		serverOptions = append(serverOptions, grpc.Creds(tlsCredentials))
 	}
+	// zero keeps the gRPC defaults, 4MB received and unlimited sent
+	if cfg.MaxMsgSize > 0 {
+		serverOptions = append(serverOptions, grpc.MaxSendMsgSize(cfg.MaxMsgSize), grpc.MaxRecvMsgSize(cfg.MaxMsgSize))
+	}
+	server := grpc.NewServer(serverOptions...)
 
+	streams := &streamCounter{}
 	syncv1grpc.RegisterFlagSyncServiceServer(server, &syncHandler{
 		mux:                 mux,
 		log:                 l,
 		contextValues:       cfg.ContextValues,
 		deadline:            cfg.StreamDeadline,
 		disableSyncMetadata: cfg.DisableSyncMetadata,
+		streams:             streams,
 	})
+	health := healthHandler{log: l, upstream: cfg.UpstreamStatus}
+	healthv1.RegisterHealthServer(server, health)
 
 	var lis net.Listener
 	if cfg.SocketPath != "" {
 		l.Info(fmt.Sprintf("starting flag sync service at %s", cfg.SocketPath))
 		lis, err = net.Listen("unix", cfg.SocketPath)
 	} else {
-		l.Info(fmt.Sprintf("starting flag sync service on port %d", cfg.Port))
-		lis, err = net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
+		address := net.JoinHostPort(cfg.Host, strconv.Itoa(int(cfg.Port)))
+		l.Info(fmt.Sprintf("starting flag sync service on %s", address))
+		lis, err = net.Listen("tcp", address)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("error creating listener: %w", err)
@@ -107,6 +146,8 @@ func NewSyncService(cfg SvcConfigurations) (*Service, error) {
 		logger:   l,
 		mux:      mux,
 		server:   server,
+		streams:  streams,
+		health:   health,
 		startupTracker: syncTracker{
 			sources:  slices.Clone(cfg.Sources),
 			doneChan: make(chan interface{}),
@@ -163,6 +204,23 @@ func (s *Service) Emit(isResync bool, source string) {
 	}
 }
 
+// SetSources replaces the sources served to clients requesting the flags of a single source
+func (s *Service) SetSources(sources []string) {
+	if err := s.mux.SetSources(sources); err != nil {
+		s.logger.Warn(fmt.Sprintf("error while updating sync sources: %v", err))
+	}
+}
+
+// ActiveStreams returns the number of SyncFlags streams currently open, one per subscribed client
+func (s *Service) ActiveStreams() int {
+	return s.streams.count()
+}
+
+// UpstreamError returns the failure of the upstream source of the flags, nil while it is healthy
+func (s *Service) UpstreamError() error {
+	return s.health.upstreamError()
+}
+
 func (s *Service) shutdown() {
 	s.logger.Info("shutting down gRPC sync service")
 	s.server.Stop()
@@ -192,3 +250,27 @@ func (t *syncTracker) trackAndRemove(source string) {
 		close(t.doneChan)
 	}
 }
+
+// streamCounter counts the open SyncFlags streams. Its methods accept a nil receiver, counting nothing.
+type streamCounter struct {
+	active atomic.Int64
+}
+
+func (c *streamCounter) open() {
+	if c != nil {
+		c.active.Add(1)
+	}
+}
+
+func (c *streamCounter) close() {
+	if c != nil {
+		c.active.Add(-1)
+	}
+}
+
+func (c *streamCounter) count() int {
+	if c == nil {
+		return 0
+	}
+	return int(c.active.Load())
+}
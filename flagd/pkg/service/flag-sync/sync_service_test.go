@@ -10,8 +10,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/open-feature/flagd/core/pkg/model"
 	"github.com/open-feature/flagd/core/pkg/store"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
 
 	"buf.build/gen/go/open-feature/flagd/grpc/go/flagd/sync/v1/syncv1grpc"
@@ -190,6 +192,201 @@ func TestSyncServiceEndToEnd(t *testing.T) {
 	}
 }
 
+func TestSyncServiceClientCertificates(t *testing.T) {
+	testCases := []struct {
+		title      string
+		clientCert string
+		clientKey  string
+		wantErr    bool
+	}{
+		{title: "with a valid client certificate", clientCert: "./test-cert/client-cert.pem", clientKey: "./test-cert/client-key.pem", wantErr: false},
+		{title: "without a client certificate", wantErr: true},
+		{title: "with a client certificate of an untrusted CA", clientCert: "./test-cert/server-cert.pem", clientKey: "./test-cert/server-key.pem", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			port := 18026
+			flagStore, sources := getSimpleFlagStore(t)
+
+			ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancelFunc()
+
+			service, err := NewSyncService(SvcConfigurations{
+				Logger:       logger.NewLogger(nil, false),
+				Port:         uint16(port),
+				Sources:      sources,
+				Store:        flagStore,
+				CertPath:     "./test-cert/server-cert.pem",
+				KeyPath:      "./test-cert/server-key.pem",
+				ClientCAPath: "./test-cert/client-ca-cert.pem",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error creating the service: %v", err)
+			}
+
+			doneChan := make(chan interface{})
+			go func() {
+				_ = service.Start(ctx)
+				close(doneChan)
+			}()
+			for _, source := range sources {
+				service.Emit(false, source)
+			}
+
+			var tlsCredentials credentials.TransportCredentials
+			if tc.clientCert != "" {
+				tlsCredentials, err = loadMutualTLSClientCredentials("./test-cert/ca-cert.pem", tc.clientCert, tc.clientKey)
+			} else {
+				tlsCredentials, err = loadTLSClientCredentials("./test-cert/ca-cert.pem")
+			}
+			if err != nil {
+				t.Fatalf("cannot load TLS credentials: %v", err)
+			}
+			con, err := grpc.Dial(fmt.Sprintf("0.0.0.0:%d", port), grpc.WithTransportCredentials(tlsCredentials))
+			if err != nil {
+				t.Fatalf("error creating grpc dial ctx: %v", err)
+			}
+			defer con.Close()
+
+			stream, err := syncv1grpc.NewFlagSyncServiceClient(con).SyncFlags(ctx, &v1.SyncFlagsRequest{})
+			if err == nil {
+				_, err = stream.Recv()
+			}
+
+			if tc.wantErr && err == nil {
+				t.Fatal("expected the connection without a trusted client certificate to be rejected")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("unexpected error syncing with a trusted client certificate: %v", err)
+			}
+
+			cancelFunc()
+			<-doneChan
+		})
+	}
+}
+
+func TestSyncServiceMaxMsgSize(t *testing.T) {
+	const defaultMaxMsgSize = 4 * 1024 * 1024
+
+	testCases := []struct {
+		title      string
+		maxMsgSize int
+		wantErr    bool
+	}{
+		{title: "with a limit above the payload", maxMsgSize: 4 * defaultMaxMsgSize, wantErr: false},
+		{title: "with a limit below the payload", maxMsgSize: defaultMaxMsgSize / 4, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			port := 18028
+
+			// a flag configuration larger than the default limit
+			flagStore, err := store.NewStore(logger.NewLogger(nil, false))
+			if err != nil {
+				t.Fatalf("error creating flag store: %v", err)
+			}
+			flagStore.Update("A", "", map[string]model.Flag{
+				"large": {
+					State:          "ENABLED",
+					DefaultVariant: "large",
+					Variants:       map[string]any{"large": strings.Repeat("x", 2*defaultMaxMsgSize)},
+				},
+			}, model.Metadata{})
+
+			ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancelFunc()
+
+			service, err := NewSyncService(SvcConfigurations{
+				Logger:     logger.NewLogger(nil, false),
+				Port:       uint16(port),
+				Sources:    []string{"A"},
+				Store:      flagStore,
+				MaxMsgSize: tc.maxMsgSize,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error creating the service: %v", err)
+			}
+
+			doneChan := make(chan interface{})
+			go func() {
+				_ = service.Start(ctx)
+				close(doneChan)
+			}()
+			service.Emit(false, "A")
+
+			// the client raises its receive limit alike
+			con, err := grpc.DialContext(ctx, fmt.Sprintf("localhost:%d", port),
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(4*defaultMaxMsgSize)))
+			if err != nil {
+				t.Fatalf("error creating grpc dial ctx: %v", err)
+			}
+			defer con.Close()
+
+			stream, err := syncv1grpc.NewFlagSyncServiceClient(con).SyncFlags(ctx, &v1.SyncFlagsRequest{})
+			var syncRsp *v1.SyncFlagsResponse
+			if err == nil {
+				syncRsp, err = stream.Recv()
+			}
+
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected a payload above the limit to be refused")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error syncing a payload above the default limit: %v", err)
+				}
+				if len(syncRsp.GetFlagConfiguration()) <= defaultMaxMsgSize {
+					t.Fatalf("expected a flag configuration above the default limit, got %d bytes",
+						len(syncRsp.GetFlagConfiguration()))
+				}
+			}
+
+			cancelFunc()
+			<-doneChan
+		})
+	}
+}
+
+func TestSyncServiceClientCARequiresTLS(t *testing.T) {
+	flagStore, sources := getSimpleFlagStore(t)
+
+	_, err := NewSyncService(SvcConfigurations{
+		Logger:       logger.NewLogger(nil, false),
+		Port:         18027,
+		Sources:      sources,
+		Store:        flagStore,
+		ClientCAPath: "./test-cert/client-ca-cert.pem",
+	})
+	if err == nil {
+		t.Fatal("expected error creating the service with a client CA but no TLS certificate")
+	}
+}
+
+func TestSyncServiceBindsHost(t *testing.T) {
+	flagStore, sources := getSimpleFlagStore(t)
+
+	service, err := NewSyncService(SvcConfigurations{
+		Logger:  logger.NewLogger(nil, false),
+		Host:    "127.0.0.1",
+		Port:    18030,
+		Sources: sources,
+		Store:   flagStore,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating the service: %v", err)
+	}
+	defer service.listener.Close()
+
+	if got := service.listener.Addr().String(); got != "127.0.0.1:18030" {
+		t.Fatalf("expected the listener to bind to 127.0.0.1:18030, got %s", got)
+	}
+}
+
 func TestSyncServiceDeadlineEndToEnd(t *testing.T) {
 	testCases := []struct {
 		title    string
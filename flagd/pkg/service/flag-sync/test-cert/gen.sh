@@ -15,3 +15,8 @@ openssl req -newkey rsa:4096 -nodes -keyout server-key.pem -out server-req.pem -
 # 3. Use CA's private key to sign web server's CSR and get back the signed certificate
 openssl x509 -req -in server-req.pem -days 9999 -CA ca-cert.pem -CAkey ca-key.pem -CAcreateserial -out server-cert.pem -extfile server-ext.cnf
 
+
+# 4. Generate the CA trusted to sign client certificates for mutual TLS, and a client certificate signed by it
+openssl req -x509 -newkey rsa:4096 -days 9999 -nodes -keyout client-ca-key.pem -out client-ca-cert.pem -subj "/CN=flagD test client CA"
+openssl req -newkey rsa:4096 -nodes -keyout client-key.pem -out client-req.pem -subj "/CN=flagD test client"
+openssl x509 -req -in client-req.pem -days 9999 -CA client-ca-cert.pem -CAkey client-ca-key.pem -CAcreateserial -out client-cert.pem -extfile client-ext.cnf
@@ -72,3 +72,29 @@ func loadTLSClientCredentials(certPath string) (credentials.TransportCredentials
 
 	return credentials.NewTLS(config), nil
 }
+
+func loadMutualTLSClientCredentials(caPath string, certPath string, keyPath string) (credentials.TransportCredentials, error) {
+	// Load the client's certificate and private key, presented to the server
+	clientCert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load key pair from certificate paths '%s' and '%s': %w", certPath, keyPath, err)
+	}
+
+	pemServerCA, err := os.ReadFile(caPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file from path '%s'", caPath)
+	}
+
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(pemServerCA) {
+		return nil, fmt.Errorf("failed to add server CA's certificate")
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{clientCert},
+		RootCAs:      certPool,
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	return credentials.NewTLS(config), nil
+}
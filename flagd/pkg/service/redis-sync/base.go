@@ -0,0 +1,72 @@
+package redissync
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	coresync "github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/open-feature/flagd/core/pkg/sync/redis"
+	"github.com/open-feature/flagd/core/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// loadBaseFile reads the base flag configuration of path, a JSON or YAML file, converted to JSON
+func loadBaseFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read base flag file: %w", err)
+	}
+
+	data, err := utils.ConvertToJSON(content, filepath.Ext(path), "")
+	if err != nil {
+		return "", fmt.Errorf("unable to read base flag file %s: %w", path, err)
+	}
+	if validationErrors := validateFlagConfiguration(data); len(validationErrors) > 0 {
+		return "", fmt.Errorf("base flag file %s rejected: %w", path, errors.Join(asErrors(validationErrors)...))
+	}
+	return data, nil
+}
+
+// overlayBase merges the configuration of data over the base configuration, the flags and evaluators from Redis
+// taking precedence on conflicts
+func (s *Service) overlayBase(data coresync.DataSync) (coresync.DataSync, error) {
+	if s.baseConfig == "" {
+		return data, nil
+	}
+
+	merged, err := redis.MergeDocuments(
+		[]string{s.baseConfig, data.FlagData},
+		[]string{"base flag file", data.Source},
+		nil,
+	)
+	if err != nil {
+		return data, fmt.Errorf("failed to overlay the base flag file: %w", err)
+	}
+	data.FlagData = merged
+	return data, nil
+}
+
+// applyBaseConfig loads the base configuration into the store for sources, serving it until their first fetch from
+// Redis is overlaid
+func (s *Service) applyBaseConfig(sources []string) {
+	if s.baseConfig == "" {
+		return
+	}
+
+	for _, source := range sources {
+		s.mu.Lock()
+		notifications, _, err := s.evaluator.SetState(coresync.DataSync{FlagData: s.baseConfig, Source: source})
+		if err == nil {
+			s.recordFlagChanges(notifications)
+		}
+		s.mu.Unlock()
+
+		if err != nil {
+			s.logger.Error("failed to load the base flag file", zap.String("source", source), zap.Error(err))
+			continue
+		}
+		s.syncService.Emit(false, source)
+	}
+}
@@ -0,0 +1,86 @@
+package redissync
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/evaluator"
+	coresync "github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/stretchr/testify/require"
+)
+
+// defaultVariants returns the default variant of every flag of the configuration served by svc
+func defaultVariants(t *testing.T, svc *Service) map[string]string {
+	t.Helper()
+
+	data, err := svc.GetFlagConfiguration()
+	require.NoError(t, err)
+
+	var config struct {
+		Flags map[string]struct {
+			DefaultVariant string `json:"defaultVariant"`
+		} `json:"flags"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(data), &config))
+
+	variants := make(map[string]string, len(config.Flags))
+	for key, flag := range config.Flags {
+		variants[key] = flag.DefaultVariant
+	}
+	return variants
+}
+
+func TestService_OverlaysRedisOnBaseFile(t *testing.T) {
+	svc := newTestService(t, nil)
+	svc.evaluator = evaluator.NewJSON(svc.logger, svc.flagStore)
+	svc.flagStore.FlagSources = []string{"redis"}
+	svc.baseConfig = `{"flags":{` +
+		`"base-only":{"state":"ENABLED","variants":{"on":true,"off":false},"defaultVariant":"on"},` +
+		`"shared":{"state":"ENABLED","variants":{"on":true,"off":false},"defaultVariant":"on"}}}`
+
+	// the base file is served before Redis is reached
+	svc.applyBaseConfig([]string{"redis"})
+	require.Equal(t, map[string]string{"base-only": "on", "shared": "on"}, defaultVariants(t, svc))
+
+	// Redis wins on conflicts
+	redisConfig := `{"flags":{` +
+		`"shared":{"state":"ENABLED","variants":{"on":true,"off":false},"defaultVariant":"off"},` +
+		`"redis-only":{"state":"ENABLED","variants":{"on":true,"off":false},"defaultVariant":"off"}}}`
+	require.NoError(t, svc.updateStoreFromSyncData(context.Background(),
+		coresync.DataSync{FlagData: redisConfig, Source: "redis"}))
+	require.Equal(t, map[string]string{"base-only": "on", "shared": "off", "redis-only": "off"},
+		defaultVariants(t, svc))
+
+	// a flag removed from Redis falls back to the base file
+	require.NoError(t, svc.updateStoreFromSyncData(context.Background(),
+		coresync.DataSync{FlagData: flagConfig("redis-only"), Source: "redis"}))
+	require.Equal(t, map[string]string{"base-only": "on", "shared": "on", "redis-only": "on"},
+		defaultVariants(t, svc))
+}
+
+func TestLoadBaseFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	data, err := loadBaseFile(write("flags.json", flagConfig("a")))
+	require.NoError(t, err)
+	require.JSONEq(t, flagConfig("a"), data)
+
+	data, err = loadBaseFile(write("flags.yaml", "flags:\n  a:\n    state: ENABLED\n    variants:\n"+
+		"      \"on\": true\n      \"off\": false\n    defaultVariant: \"on\"\n"))
+	require.NoError(t, err)
+	require.JSONEq(t, flagConfig("a"), data)
+
+	_, err = loadBaseFile(write("invalid.json", `{"flags":{"a":{"state":"ENABLED"}}}`))
+	require.ErrorContains(t, err, "rejected")
+
+	_, err = loadBaseFile(filepath.Join(dir, "missing.json"))
+	require.ErrorContains(t, err, "unable to read base flag file")
+}
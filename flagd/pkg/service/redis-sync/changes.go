@@ -0,0 +1,108 @@
+package redissync
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/model"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// FlagChangeType classifies the change of a flag applied to the store
+type FlagChangeType string
+
+const (
+	FlagAdded   FlagChangeType = "added"
+	FlagUpdated FlagChangeType = "updated"
+	FlagDeleted FlagChangeType = "deleted"
+)
+
+// recentChangesSize bounds the number of flag changes kept for the status
+const recentChangesSize = 100
+
+// FlagChange describes a change of a flag applied to the store
+type FlagChange struct {
+	FlagKey string         `json:"flagKey"`
+	Type    FlagChangeType `json:"type"`
+	Source  string         `json:"source"`
+	Time    time.Time      `json:"time"`
+}
+
+// flagChanges classifies the notifications returned by the evaluator for an update applied at, ordered by flag key
+func flagChanges(notifications map[string]interface{}, at time.Time) []FlagChange {
+	changes := make([]FlagChange, 0, len(notifications))
+	for key, notification := range notifications {
+		details, ok := notification.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		change := FlagChange{FlagKey: key, Time: at}
+		change.Source, _ = details["source"].(string)
+		switch notificationType, _ := details["type"].(string); model.StateChangeNotificationType(notificationType) {
+		case model.NotificationCreate:
+			change.Type = FlagAdded
+		case model.NotificationUpdate:
+			change.Type = FlagUpdated
+		case model.NotificationDelete:
+			change.Type = FlagDeleted
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	slices.SortFunc(changes, func(a, b FlagChange) int {
+		return strings.Compare(a.FlagKey, b.FlagKey)
+	})
+	return changes
+}
+
+// changeRing keeps the most recent flag changes, overwriting the oldest once full
+type changeRing struct {
+	changes []FlagChange
+	next    int
+	full    bool
+}
+
+func (r *changeRing) add(change FlagChange) {
+	if r.changes == nil {
+		r.changes = make([]FlagChange, recentChangesSize)
+	}
+
+	r.changes[r.next] = change
+	r.next = (r.next + 1) % len(r.changes)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// list returns the kept changes, oldest first
+func (r *changeRing) list() []FlagChange {
+	if !r.full {
+		return slices.Clone(r.changes[:r.next])
+	}
+	return append(slices.Clone(r.changes[r.next:]), r.changes[:r.next]...)
+}
+
+// newFlagChangesCounter creates the counter of the flag changes applied to the store, labelled by change type
+func newFlagChangesCounter() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "flagd",
+		Subsystem: "redis_sync",
+		Name:      "flag_changes_total",
+		Help:      "Number of flags added, updated or deleted by the configurations applied to the store",
+	}, []string{"type"})
+}
+
+// recordFlagChanges keeps the changes of the notifications returned by the evaluator and counts them. The caller
+// holds s.mu.
+func (s *Service) recordFlagChanges(notifications map[string]interface{}) {
+	for _, change := range flagChanges(notifications, time.Now()) {
+		s.recentChanges.add(change)
+		if s.flagChanges != nil {
+			s.flagChanges.WithLabelValues(string(change.Type)).Inc()
+		}
+	}
+}
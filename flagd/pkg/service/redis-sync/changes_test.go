@@ -0,0 +1,67 @@
+package redissync
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/evaluator"
+	coresync "github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_RecordsFlagChanges(t *testing.T) {
+	svc := newTestService(t, nil)
+	svc.evaluator = evaluator.NewJSON(svc.logger, svc.flagStore)
+	svc.flagChanges = newFlagChangesCounter()
+
+	before := flagConfig("kept", "changed", "removed")
+	after := `{"flags":{` +
+		`"kept":{"state":"ENABLED","variants":{"on":true,"off":false},"defaultVariant":"on"},` +
+		`"changed":{"state":"ENABLED","variants":{"on":true,"off":false},"defaultVariant":"off"},` +
+		`"added":{"state":"ENABLED","variants":{"on":true,"off":false},"defaultVariant":"on"}}}`
+
+	require.NoError(t, svc.updateStoreFromSyncData(context.Background(),
+		coresync.DataSync{FlagData: before, Source: "redis"}))
+	require.NoError(t, svc.updateStoreFromSyncData(context.Background(),
+		coresync.DataSync{FlagData: after, Source: "redis"}))
+
+	changes := svc.Status().RecentChanges
+	require.Len(t, changes, 6)
+
+	var got []string
+	for _, change := range changes {
+		require.Equal(t, "redis", change.Source)
+		require.False(t, change.Time.IsZero())
+		got = append(got, fmt.Sprintf("%s:%s", change.FlagKey, change.Type))
+	}
+	require.Equal(t, []string{
+		"changed:added", "kept:added", "removed:added",
+		"added:added", "changed:updated", "removed:deleted",
+	}, got)
+
+	require.InDelta(t, 4, testutil.ToFloat64(svc.flagChanges.WithLabelValues(string(FlagAdded))), 0)
+	require.InDelta(t, 1, testutil.ToFloat64(svc.flagChanges.WithLabelValues(string(FlagUpdated))), 0)
+	require.InDelta(t, 1, testutil.ToFloat64(svc.flagChanges.WithLabelValues(string(FlagDeleted))), 0)
+
+	// an unchanged configuration records nothing
+	require.NoError(t, svc.updateStoreFromSyncData(context.Background(),
+		coresync.DataSync{FlagData: after, Source: "redis"}))
+	require.Len(t, svc.Status().RecentChanges, 6)
+}
+
+func TestChangeRing_KeepsMostRecent(t *testing.T) {
+	var ring changeRing
+	require.Empty(t, ring.list())
+
+	for i := range recentChangesSize + 5 {
+		ring.add(FlagChange{FlagKey: fmt.Sprintf("flag-%d", i), Type: FlagAdded, Time: time.Now()})
+	}
+
+	changes := ring.list()
+	require.Len(t, changes, recentChangesSize)
+	require.Equal(t, "flag-5", changes[0].FlagKey)
+	require.Equal(t, fmt.Sprintf("flag-%d", recentChangesSize+4), changes[len(changes)-1].FlagKey)
+}
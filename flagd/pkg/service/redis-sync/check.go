@@ -0,0 +1,53 @@
+package redissync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/sync/redis"
+)
+
+// CheckResult summarizes the configuration fetched by Check
+type CheckResult struct {
+	// Flags is the number of flags defined by the configuration
+	Flags int
+	// SHA is the hash of the configuration
+	SHA string
+	// JSONModule is whether the configuration was read with the Redis JSON module
+	JSONModule bool
+}
+
+// Check connects redisSync and fetches its configuration once, validating it against the structure and flag schema
+// the service requires, without syncing. The cache file is ignored, so that an unreachable Redis fails the check
+// rather than the cached configuration being validated.
+func Check(ctx context.Context, redisSync *redis.Sync, log *logger.Logger) (CheckResult, error) {
+	redisSync.CacheFile = ""
+	if err := redisSync.Init(ctx); err != nil {
+		return CheckResult{}, err
+	}
+
+	data, err := redisSync.Fetch(ctx)
+	if err != nil {
+		return CheckResult{}, fmt.Errorf("failed to fetch the configuration from Redis: %w", err)
+	}
+	if data == "" {
+		return CheckResult{}, fmt.Errorf("Redis key %s not found or empty", redisSync.Key)
+	}
+
+	if validationErrors := validateFlagConfiguration(data); len(validationErrors) > 0 {
+		return CheckResult{}, fmt.Errorf("flag configuration rejected: %w", errors.Join(asErrors(validationErrors)...))
+	}
+	if validationErrors := validateSchema(log, data); len(validationErrors) > 0 {
+		return CheckResult{}, fmt.Errorf("flag configuration rejected by the flag schema: %w",
+			errors.Join(asErrors(validationErrors)...))
+	}
+
+	stats := redisSync.Stats()
+	return CheckResult{
+		Flags:      countFlags(data),
+		SHA:        stats.LastSHA,
+		JSONModule: stats.JSONModule,
+	}, nil
+}
@@ -0,0 +1,101 @@
+package redissync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// startHealthServer serves the liveness and readiness probes until ctx is cancelled
+func (s *Service) startHealthServer(ctx context.Context) error {
+	s.logger.Info("health probes listening", zap.Uint16("port", s.healthPort))
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", s.healthPort),
+		ReadHeaderTimeout: 3 * time.Second,
+		Handler:           s.healthHandler(),
+	}
+
+	go func() {
+		<-ctx.Done()
+		if err := server.Shutdown(context.Background()); err != nil {
+			s.logger.Error("error shutting down health server", zap.Error(err))
+		}
+	}()
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("error returned from health server: %w", err)
+	}
+	return nil
+}
+
+// healthHandler serves /healthz, successful while the service runs, and /readyz, successful once the store was
+// populated and as long as the last fetch of every provider succeeded
+func (s *Service) healthHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, _ *http.Request) {
+		if !s.isServing() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	return mux
+}
+
+// isServing reports whether the store was populated from Redis and every provider is ready, its last fetch having
+// succeeded
+func (s *Service) isServing() bool {
+	return s.servingError() == nil
+}
+
+// servingError returns why the service isn't serving, nil once a configuration was applied to the store and while
+// every provider is ready. A provider is ready once its initial fetch completed, even when it found no configuration,
+// so the service waits for a configuration to be applied to the store as well. It gates both /readyz and the gRPC
+// health service, so that clients connecting early don't take the empty store for the flags.
+func (s *Service) servingError() error {
+	if len(s.providers()) == 0 {
+		return errors.New("no Redis source is configured")
+	}
+
+	s.mu.RLock()
+	populated := !s.lastSync.IsZero()
+	s.mu.RUnlock()
+	if !populated {
+		return errors.New("no flag configuration was applied from Redis yet")
+	}
+
+	return s.upstreamError()
+}
+
+// upstreamError returns why the first failing provider is degraded, nil while every provider is ready and its last
+// fetch succeeded. A failure reported on the sync error channel degrades the service until the next successful fetch
+// of that source.
+func (s *Service) upstreamError() error {
+	for _, redisSync := range s.providers() {
+		source := redisSync.SourceName()
+		if !redisSync.IsReady() {
+			return fmt.Errorf("Redis source %s is not ready", source)
+		}
+
+		stats := redisSync.Stats()
+		s.mu.RLock()
+		lastFailure := s.lastFailures[source]
+		s.mu.RUnlock()
+
+		if stats.LastError != nil {
+			return fmt.Errorf("fetch from Redis source %s failed: %w", source, stats.LastError)
+		}
+		if lastFailure.After(stats.LastSyncTime) {
+			return fmt.Errorf("fetch from Redis source %s failed at %s", source, lastFailure.Format(time.RFC3339))
+		}
+	}
+	return nil
+}
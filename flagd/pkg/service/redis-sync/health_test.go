@@ -0,0 +1,200 @@
+package redissync
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"buf.build/gen/go/open-feature/flagd/grpc/go/flagd/sync/v1/syncv1grpc"
+	"github.com/open-feature/flagd/core/pkg/evaluator"
+	"github.com/open-feature/flagd/core/pkg/logger"
+	coresync "github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/open-feature/flagd/core/pkg/sync/redis"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// noopCron never runs the registered functions
+type noopCron struct{}
+
+func (noopCron) AddFunc(_ string, _ func()) error { return nil }
+func (noopCron) Start()                           {}
+func (noopCron) Stop()                            {}
+
+// probe returns the status code of the health endpoint at path
+func probe(t *testing.T, svc *Service, path string) int {
+	t.Helper()
+
+	recorder := httptest.NewRecorder()
+	svc.healthHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+	return recorder.Code
+}
+
+func TestService_HealthProbes(t *testing.T) {
+	svc := newTestService(t, nil)
+	svc.evaluator = evaluator.NewJSON(svc.logger, svc.flagStore)
+	redisSync := &redis.Sync{
+		URI:    "redis",
+		Client: fakeRedisClient{document: flagConfig("a")},
+		Cron:   noopCron{},
+		Logger: svc.logger,
+		Key:    "flags",
+	}
+	svc.redisSyncs = []*redis.Sync{redisSync}
+
+	// the provider hasn't synced yet
+	require.Equal(t, http.StatusOK, probe(t, svc, "/healthz"))
+	require.Equal(t, http.StatusServiceUnavailable, probe(t, svc, "/readyz"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dataSync := make(chan coresync.DataSync, 1)
+	go func() {
+		_ = redisSync.Sync(ctx, dataSync)
+	}()
+	require.Eventually(t, redisSync.IsReady, time.Second, 10*time.Millisecond)
+	require.NoError(t, svc.updateStoreFromSyncData(context.Background(), <-dataSync))
+
+	require.Equal(t, http.StatusOK, probe(t, svc, "/healthz"))
+	require.Equal(t, http.StatusOK, probe(t, svc, "/readyz"))
+
+	// the last fetch failed
+	redisSync.Client = fakeRedisClient{err: errors.New("connection refused")}
+	require.Error(t, redisSync.ReSync(ctx, dataSync))
+
+	require.Equal(t, http.StatusOK, probe(t, svc, "/healthz"))
+	require.Equal(t, http.StatusServiceUnavailable, probe(t, svc, "/readyz"))
+}
+
+func TestService_NotReadyUntilStorePopulated(t *testing.T) {
+	svc := newTestService(t, nil)
+	svc.evaluator = evaluator.NewJSON(svc.logger, svc.flagStore)
+	// the key doesn't exist yet, the initial fetch finding no configuration
+	redisSync := &redis.Sync{
+		URI:    "redis",
+		Client: fakeRedisClient{},
+		Cron:   noopCron{},
+		Logger: svc.logger,
+		Key:    "flags",
+	}
+	svc.redisSyncs = []*redis.Sync{redisSync}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dataSync := make(chan coresync.DataSync, 1)
+	go func() {
+		_ = redisSync.Sync(ctx, dataSync)
+	}()
+	require.Eventually(t, redisSync.IsReady, time.Second, 10*time.Millisecond)
+
+	// the provider completed its initial fetch, yet the store is empty
+	require.False(t, svc.IsReady())
+	require.Equal(t, http.StatusServiceUnavailable, probe(t, svc, "/readyz"))
+
+	// the key is created and its configuration applied
+	redisSync.Client = fakeRedisClient{document: flagConfig("a")}
+	require.NoError(t, redisSync.ReSync(ctx, dataSync))
+	require.NoError(t, svc.updateStoreFromSyncData(context.Background(), <-dataSync))
+
+	require.True(t, svc.IsReady())
+	require.Equal(t, http.StatusOK, probe(t, svc, "/readyz"))
+}
+
+func TestService_SyncServiceReportsUpstreamFailure(t *testing.T) {
+	svc, err := NewService(Config{
+		RedisURIs: []string{"redis://localhost:6379/0?key=flags"},
+		Logger:    logger.NewLogger(zap.NewNop(), false),
+	})
+	require.NoError(t, err)
+	require.NoError(t, svc.redisSyncs[0].Close())
+
+	// the provider hasn't synced yet
+	require.ErrorContains(t, svc.syncService.UpstreamError(), "no flag configuration was applied from Redis yet")
+
+	redisSync := &redis.Sync{
+		URI:    "redis",
+		Client: fakeRedisClient{document: flagConfig("a")},
+		Cron:   noopCron{},
+		Logger: svc.logger,
+		Key:    "flags",
+	}
+	svc.redisSyncs = []*redis.Sync{redisSync}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dataSync := make(chan coresync.DataSync, 1)
+	go func() {
+		_ = redisSync.Sync(ctx, dataSync)
+	}()
+	require.Eventually(t, redisSync.IsReady, time.Second, 10*time.Millisecond)
+	require.NoError(t, svc.updateStoreFromSyncData(context.Background(), <-dataSync))
+	require.NoError(t, svc.syncService.UpstreamError())
+
+	// the last fetch failed
+	redisSync.Client = fakeRedisClient{err: errors.New("connection refused")}
+	require.Error(t, redisSync.ReSync(ctx, dataSync))
+	require.ErrorContains(t, svc.syncService.UpstreamError(), "connection refused")
+}
+
+func TestService_SyncServiceNotServingUntilStorePopulated(t *testing.T) {
+	// unix socket paths are limited in length, which a test directory may exceed
+	dir, err := os.MkdirTemp("", "redis-sync")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	socketPath := filepath.Join(dir, "sync.sock")
+
+	svc, err := NewService(Config{
+		RedisURIs:  []string{"redis://localhost:6379/0?key=flags"},
+		SocketPath: socketPath,
+		Logger:     logger.NewLogger(zap.NewNop(), false),
+	})
+	require.NoError(t, err)
+	require.NoError(t, svc.redisSyncs[0].Close())
+
+	redisSync := &redis.Sync{
+		URI:    "redis",
+		Client: fakeRedisClient{document: flagConfig("a")},
+		Cron:   noopCron{},
+		Logger: svc.logger,
+		Key:    "flags",
+	}
+	svc.redisSyncs = []*redis.Sync{redisSync}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = svc.syncService.Start(ctx)
+	}()
+
+	conn, err := grpc.NewClient("unix://"+socketPath, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := healthv1.NewHealthClient(conn)
+	check := func() healthv1.HealthCheckResponse_ServingStatus {
+		t.Helper()
+		rsp, err := client.Check(ctx, &healthv1.HealthCheckRequest{
+			Service: syncv1grpc.FlagSyncService_ServiceDesc.ServiceName,
+		}, grpc.WaitForReady(true))
+		require.NoError(t, err)
+		return rsp.GetStatus()
+	}
+
+	// the provider completed its initial fetch, yet its configuration wasn't applied to the store
+	dataSync := make(chan coresync.DataSync, 1)
+	go func() {
+		_ = redisSync.Sync(ctx, dataSync)
+	}()
+	require.Eventually(t, redisSync.IsReady, time.Second, 10*time.Millisecond)
+	require.Equal(t, healthv1.HealthCheckResponse_NOT_SERVING, check())
+
+	require.NoError(t, svc.updateStoreFromSyncData(context.Background(), <-dataSync))
+	require.Equal(t, healthv1.HealthCheckResponse_SERVING, check())
+}
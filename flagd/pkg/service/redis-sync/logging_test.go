@@ -0,0 +1,34 @@
+package redissync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/evaluator"
+	"github.com/open-feature/flagd/core/pkg/logger"
+	coresync "github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestService_UpdateLogsStructuredFields(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	svc := newTestService(t, nil)
+	svc.logger = logger.NewLogger(zap.New(core), false)
+	svc.evaluator = evaluator.NewJSON(svc.logger, svc.flagStore)
+
+	config := flagConfig("new-ui", "dark-mode")
+	require.NoError(t, svc.updateStoreFromSyncData(context.Background(),
+		coresync.DataSync{FlagData: config, Source: "redis"}))
+
+	updated := logs.FilterMessage("Store updated successfully").All()
+	require.Len(t, updated, 1)
+	fields := updated[0].ContextMap()
+	require.Equal(t, "redis", fields["source"])
+	require.EqualValues(t, len(config), fields["byteSize"])
+	require.EqualValues(t, 2, fields["flagsChanged"])
+	require.Equal(t, false, fields["resyncRequired"])
+	require.Contains(t, fields, "duration")
+}
@@ -0,0 +1,64 @@
+package redissync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// ErrWriteDisabled is returned by PublishConfig unless the service was configured to allow writing to Redis
+var ErrWriteDisabled = errors.New("writing to Redis is disabled, enable it with --redis-allow-write")
+
+// PublishConfig validates the flag configuration config and writes it to the key of the Redis source, from which it
+// is synced back to the store like any other change. It requires AllowWrite and a single source, and is rejected
+// like a fetched configuration when invalid, leaving Redis unchanged.
+func (s *Service) PublishConfig(ctx context.Context, config string) error {
+	s.mu.RLock()
+	allowWrite, redisSyncs := s.allowWrite, s.redisSyncs
+	s.mu.RUnlock()
+
+	if !allowWrite {
+		return ErrWriteDisabled
+	}
+	if len(redisSyncs) != 1 {
+		return fmt.Errorf("publishing requires a single Redis source, %d are configured", len(redisSyncs))
+	}
+	redisSync := redisSyncs[0]
+	source := redisSync.SourceName()
+
+	if validationErrors := s.validatePublished(source, config); len(validationErrors) > 0 {
+		return fmt.Errorf("flag configuration rejected: %w", errors.Join(asErrors(validationErrors)...))
+	}
+
+	if err := redisSync.Publish(ctx, config); err != nil {
+		return fmt.Errorf("failed to publish the flag configuration to %s: %w", source, err)
+	}
+	s.logger.Info("Published a flag configuration", zap.String("source", source), zap.Int("byteSize", len(config)))
+	return nil
+}
+
+// validatePublished checks config as a configuration fetched from source would be, without recording the errors in
+// the status of the service
+func (s *Service) validatePublished(source string, config string) []ValidationError {
+	if validationErrors := validateFlagConfiguration(config); len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	s.mu.RLock()
+	strictSchema, minFlags := s.strictSchema[source], s.minFlags[source]
+	s.mu.RUnlock()
+
+	if strictSchema {
+		if validationErrors := validateSchema(s.logger, config); len(validationErrors) > 0 {
+			return validationErrors
+		}
+	}
+	if flags := countFlags(config); flags < minFlags {
+		return []ValidationError{{
+			Reason: fmt.Sprintf("configuration defines %d flags, fewer than the minimum of %d", flags, minFlags),
+		}}
+	}
+	return nil
+}
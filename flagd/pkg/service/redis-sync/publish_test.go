@@ -0,0 +1,87 @@
+package redissync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/sync/redis"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_PublishConfig(t *testing.T) {
+	var published string
+	svc := newTestService(t, nil)
+	svc.allowWrite = true
+	svc.redisSyncs = []*redis.Sync{{
+		URI:    "redis://localhost:6379/0?key=flags",
+		Client: fakeRedisClient{published: &published},
+		Logger: svc.logger,
+		Key:    "flags",
+	}}
+
+	config := flagConfig("new-ui")
+	require.NoError(t, svc.PublishConfig(context.Background(), config))
+	require.Equal(t, config, published)
+}
+
+func TestService_PublishConfigRejected(t *testing.T) {
+	tests := map[string]struct {
+		allowWrite    bool
+		sources       int
+		config        string
+		expectedError string
+	}{
+		"write disabled": {
+			sources:       1,
+			config:        flagConfig("new-ui"),
+			expectedError: ErrWriteDisabled.Error(),
+		},
+		"invalid configuration": {
+			allowWrite:    true,
+			sources:       1,
+			config:        `{"flags":{"new-ui":{"state":"ON"}}}`,
+			expectedError: "flag configuration rejected",
+		},
+		"several sources": {
+			allowWrite:    true,
+			sources:       2,
+			config:        flagConfig("new-ui"),
+			expectedError: "single Redis source",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var published string
+			svc := newTestService(t, nil)
+			svc.allowWrite = tt.allowWrite
+			for range tt.sources {
+				svc.redisSyncs = append(svc.redisSyncs, &redis.Sync{
+					URI:    "redis://localhost:6379/0?key=flags",
+					Client: fakeRedisClient{published: &published},
+					Logger: svc.logger,
+					Key:    "flags",
+				})
+			}
+
+			require.ErrorContains(t, svc.PublishConfig(context.Background(), tt.config), tt.expectedError)
+			require.Empty(t, published, "nothing is written to Redis")
+		})
+	}
+}
+
+func TestService_PublishConfigEnforcesMinFlags(t *testing.T) {
+	var published string
+	svc := newTestService(t, nil)
+	svc.allowWrite = true
+	svc.minFlags = map[string]int{"flags": 2}
+	svc.redisSyncs = []*redis.Sync{{
+		Name:   "flags",
+		Client: fakeRedisClient{published: &published},
+		Logger: svc.logger,
+		Key:    "flags",
+	}}
+
+	require.ErrorContains(t, svc.PublishConfig(context.Background(), flagConfig("new-ui")), "fewer than the minimum")
+	require.Empty(t, published)
+}
@@ -0,0 +1,20 @@
+package redissync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// RefreshNow fetches the configuration of every source immediately, rather than at its next poll, and applies the
+// changed ones through the same path as polled updates. A failed fetch doesn't stop the other sources from being
+// refreshed, the failures being returned together.
+func (s *Service) RefreshNow(ctx context.Context) error {
+	var err error
+	for _, redisSync := range s.providers() {
+		if resyncErr := redisSync.ReSync(ctx, s.dataSync); resyncErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to refresh %s: %w", redisSync.SourceName(), resyncErr))
+		}
+	}
+	return err
+}
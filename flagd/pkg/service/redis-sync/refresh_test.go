@@ -0,0 +1,70 @@
+package redissync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/evaluator"
+	"github.com/open-feature/flagd/core/pkg/sync/redis"
+	flagsync "github.com/open-feature/flagd/flagd/pkg/service/flag-sync"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_RefreshNowUpdatesStore(t *testing.T) {
+	svc := newTestService(t, nil)
+	svc.evaluator = evaluator.NewJSON(svc.logger, svc.flagStore)
+	svc.shutdownTimeout = time.Second
+
+	// the provider never polls, only the initial fetch and refreshes reach Redis
+	redisSync := &redis.Sync{
+		URI:    "redis",
+		Client: fakeRedisClient{document: flagConfig("a")},
+		Cron:   noopCron{},
+		Logger: svc.logger,
+		Key:    "flags",
+	}
+	svc.redisSyncs = []*redis.Sync{redisSync}
+	svc.flagStore.FlagSources = []string{"redis"}
+
+	syncService, err := flagsync.NewSyncService(flagsync.SvcConfigurations{
+		Logger:  svc.logger,
+		Store:   svc.flagStore,
+		Sources: svc.flagStore.FlagSources,
+	})
+	require.NoError(t, err)
+	svc.syncService = syncService
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- svc.Start(context.Background())
+	}()
+	require.Eventually(t, svc.IsReady, time.Second, 10*time.Millisecond)
+
+	hasFlag := func(key string) func() bool {
+		return func() bool {
+			var config struct {
+				Flags map[string]json.RawMessage `json:"flags"`
+			}
+			data, err := svc.GetFlagConfiguration()
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal([]byte(data), &config))
+			_, found := config.Flags[key]
+			return found
+		}
+	}
+	require.Eventually(t, hasFlag("a"), time.Second, 10*time.Millisecond)
+
+	// a hotfix is pushed to Redis and picked up without waiting for a poll
+	redisSync.Client = fakeRedisClient{document: flagConfig("a", "hotfix")}
+	require.NoError(t, svc.RefreshNow(context.Background()))
+	require.Eventually(t, hasFlag("hotfix"), time.Second, 10*time.Millisecond)
+
+	redisSync.Client = fakeRedisClient{err: errors.New("connection refused")}
+	require.ErrorContains(t, svc.RefreshNow(context.Background()), "connection refused")
+
+	require.NoError(t, svc.Shutdown())
+	require.NoError(t, <-errs)
+}
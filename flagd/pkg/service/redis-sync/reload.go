@@ -0,0 +1,226 @@
+package redissync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/open-feature/flagd/core/pkg/model"
+	"github.com/open-feature/flagd/core/pkg/sync/redis"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// providerSet holds the Redis sync providers created from a configuration, one per URI
+type providerSet struct {
+	syncs []*redis.Sync
+	// sources are the names of the providers, see redis.Sync.SourceName, in order
+	sources []string
+	// strictSchema holds the sources rejecting configurations that don't conform to the flag schema
+	strictSchema map[string]bool
+	// minFlags holds the fewest flags the configuration of each source may define, zero when unguarded
+	minFlags map[string]int
+}
+
+// newProviders creates a provider per URI of cfg with build, all reporting failed fetches on syncErrors
+func newProviders(
+	cfg Config,
+	build func(uri string, cfg Config) (*redis.Sync, error),
+	syncErrors chan redis.SyncError,
+) (providerSet, error) {
+	uris := cfg.RedisURIs
+	if cfg.RedisURI != "" {
+		uris = append([]string{cfg.RedisURI}, uris...)
+	}
+	if len(uris) == 0 {
+		return providerSet{}, errors.New("at least one Redis URI is required")
+	}
+	if cfg.CacheFile != "" && len(uris) > 1 {
+		return providerSet{}, errors.New("a cache file can only be set with a single Redis URI")
+	}
+
+	providers := providerSet{strictSchema: map[string]bool{}, minFlags: map[string]int{}}
+	for _, uri := range uris {
+		redisSync, err := build(uri, cfg)
+		if err != nil {
+			closeProviders(providers.syncs)
+			return providerSet{}, fmt.Errorf("failed to create Redis sync provider: %w", err)
+		}
+
+		source := redisSync.SourceName()
+		if slices.Contains(providers.sources, source) {
+			closeProviders(append(providers.syncs, redisSync))
+			return providerSet{}, fmt.Errorf("Redis source %s is configured more than once", source)
+		}
+		redisSync.Errors = syncErrors
+
+		providers.syncs = append(providers.syncs, redisSync)
+		providers.sources = append(providers.sources, source)
+		providers.strictSchema[source] = redisSync.StrictSchema()
+		providers.minFlags[source] = redisSync.MinFlags
+	}
+	return providers, nil
+}
+
+// initProviders initializes every provider, connecting to Redis
+func initProviders(ctx context.Context, redisSyncs []*redis.Sync) error {
+	for _, redisSync := range redisSyncs {
+		if err := redisSync.Init(ctx); err != nil {
+			return fmt.Errorf("failed to initialize Redis sync provider %s: %w", redisSync.SourceName(), err)
+		}
+	}
+	return nil
+}
+
+// closeProviders closes the Redis connections of providers that never ran
+func closeProviders(redisSyncs []*redis.Sync) {
+	for _, redisSync := range redisSyncs {
+		_ = redisSync.Close()
+	}
+}
+
+// providers returns the current Redis sync providers
+func (s *Service) providers() []*redis.Sync {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.redisSyncs
+}
+
+// runProviders syncs the current providers into the data channel until ctx is cancelled or one of them fails,
+// starting over with the new providers whenever Reload stops them
+func (s *Service) runProviders(ctx context.Context) error {
+	for {
+		providersCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+
+		s.mu.Lock()
+		redisSyncs := s.redisSyncs
+		s.stopProviders = func() {
+			cancel()
+			<-done
+		}
+		s.mu.Unlock()
+
+		g, gCtx := errgroup.WithContext(providersCtx)
+		for _, redisSync := range redisSyncs {
+			g.Go(func() error {
+				s.logger.Info("Starting Redis sync provider...", zap.String("source", redisSync.SourceName()))
+				if err := redisSync.Sync(gCtx, s.dataSync); err != nil {
+					return fmt.Errorf("Redis sync error for %s: %w", redisSync.SourceName(), err)
+				}
+				return nil
+			})
+		}
+		err := g.Wait()
+
+		// the providers were stopped by a reload only when neither ctx ended nor they stopped on their own
+		reloaded := providersCtx.Err() != nil && ctx.Err() == nil
+		cancel()
+		close(done)
+
+		if err != nil || !reloaded {
+			s.mu.Lock()
+			s.stopProviders = nil
+			s.mu.Unlock()
+			return err
+		}
+	}
+}
+
+// Reload creates the Redis sync providers of cfg and swaps them for the current ones, applying a changed URI, key,
+// interval or schedule without restarting the service. The current providers are stopped, closing their
+// connections, and the flags of sources no longer configured are removed once the data they queued was applied. The
+// other settings of cfg, such as the ports, only apply on restart.
+func (s *Service) Reload(ctx context.Context, cfg Config) error {
+	// the providers log with the service logger unless cfg sets its own
+	if cfg.Logger == nil {
+		cfg.Logger = s.logger
+	}
+
+	build := s.buildProvider
+	if build == nil {
+		build = NewProvider
+	}
+	providers, err := newProviders(cfg, build, s.syncErrors)
+	if err != nil {
+		return fmt.Errorf("failed to reload the Redis sync providers: %w", err)
+	}
+	if err := initProviders(ctx, providers.syncs); err != nil {
+		closeProviders(providers.syncs)
+		return fmt.Errorf("failed to reload the Redis sync providers: %w", err)
+	}
+
+	s.mu.Lock()
+	previousSources := s.flagStore.FlagSources
+	previous, stop := s.redisSyncs, s.stopProviders
+	s.redisSyncs = providers.syncs
+	s.stopProviders = nil
+	s.strictSchema = providers.strictSchema
+	s.minFlags = providers.minFlags
+	s.allowWrite = cfg.AllowWrite
+	s.flagStore.FlagSources = providers.sources
+	s.mu.Unlock()
+	s.syncService.SetSources(providers.sources)
+
+	// Serve the base flag file for the new sources until their first fetch
+	var added []string
+	for _, source := range providers.sources {
+		if !slices.Contains(previousSources, source) {
+			added = append(added, source)
+		}
+	}
+	s.applyBaseConfig(added)
+
+	// Stop the previous providers, which restarts the sync with the new ones, and apply the data they queued, or close
+	// them if they never ran
+	if stop != nil {
+		stop()
+		s.drainSyncData(ctx)
+	} else {
+		closeProviders(previous)
+	}
+
+	// Remove the flags of the sources no longer configured, none of their data being left to apply
+	var removed []string
+	s.mu.Lock()
+	for _, redisSync := range previous {
+		if source := redisSync.SourceName(); !slices.Contains(providers.sources, source) {
+			s.flagStore.Update(source, "", map[string]model.Flag{}, model.Metadata{})
+			delete(s.lastFailures, source)
+			s.forgetConfigSize(source)
+			removed = append(removed, source)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, source := range removed {
+		s.syncService.Emit(false, source)
+	}
+
+	s.logger.Info("Reloaded the Redis sync providers", zap.Int("sources", len(providers.sources)))
+	return nil
+}
+
+// drainSyncData waits for processSyncData to apply the flag data already queued, unless ctx ends or the service
+// stops first
+func (s *Service) drainSyncData(ctx context.Context) {
+	s.mu.RLock()
+	done := s.done
+	s.mu.RUnlock()
+
+	drained := make(chan struct{})
+	select {
+	case s.drainRequests <- drained:
+	case <-done:
+		return
+	case <-ctx.Done():
+		return
+	}
+
+	select {
+	case <-drained:
+	case <-done:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,186 @@
+package redissync
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/evaluator"
+	"github.com/open-feature/flagd/core/pkg/sync/redis"
+	flagsync "github.com/open-feature/flagd/flagd/pkg/service/flag-sync"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingCron records the schedules registered on it, without running the registered functions
+type recordingCron struct {
+	schedules chan string
+}
+
+func (c recordingCron) AddFunc(spec string, _ func()) error {
+	c.schedules <- spec
+	return nil
+}
+func (recordingCron) Start() {}
+func (recordingCron) Stop()  {}
+
+// pollingCron hands the polls registered on it to the test, which runs them
+type pollingCron struct {
+	polls chan func()
+}
+
+func (c pollingCron) AddFunc(_ string, cmd func()) error {
+	c.polls <- cmd
+	return nil
+}
+func (pollingCron) Start() {}
+func (pollingCron) Stop()  {}
+
+// changingRedisClient serves a configuration defining a new flag on every fetch, a1 then a2 and so on
+type changingRedisClient struct {
+	fakeRedisClient
+	fetches *atomic.Int32
+}
+
+func (c changingRedisClient) JSONGet(ctx context.Context, key string, paths ...string) *goredis.JSONCmd {
+	c.document = flagConfig(fmt.Sprintf("a%d", c.fetches.Add(1)))
+	return c.fakeRedisClient.JSONGet(ctx, key, paths...)
+}
+
+func (c changingRedisClient) Get(ctx context.Context, key string) *goredis.StringCmd {
+	c.document = flagConfig(fmt.Sprintf("a%d", c.fetches.Add(1)))
+	return c.fakeRedisClient.Get(ctx, key)
+}
+
+func TestService_ReloadSwapsProviders(t *testing.T) {
+	const uri = "redis://localhost:6379/0?key=flags"
+
+	svc := newTestService(t, nil)
+	svc.evaluator = evaluator.NewJSON(svc.logger, svc.flagStore)
+	svc.shutdownTimeout = time.Second
+	svc.syncErrors = make(chan redis.SyncError, 1)
+
+	// providers serve a fixed document and record their polling schedule, each closing its own flag
+	schedules := make(chan string, 2)
+	var closed []*atomic.Bool
+	svc.buildProvider = func(uri string, cfg Config) (*redis.Sync, error) {
+		redisSync, err := NewProvider(uri, cfg)
+		if err != nil {
+			return nil, err
+		}
+		require.NoError(t, redisSync.Client.Close())
+
+		closed = append(closed, &atomic.Bool{})
+		redisSync.Client = fakeRedisClient{document: flagConfig("a"), closed: closed[len(closed)-1]}
+		redisSync.Cron = recordingCron{schedules: schedules}
+		return redisSync, nil
+	}
+	require.NoError(t, svc.Reload(context.Background(), Config{RedisURIs: []string{uri}, RedisInterval: 30}))
+
+	// the gRPC server delays serving until the initial sync of its sources
+	syncService, err := flagsync.NewSyncService(flagsync.SvcConfigurations{
+		Logger:  svc.logger,
+		Store:   svc.flagStore,
+		Sources: []string{uri},
+	})
+	require.NoError(t, err)
+	syncService.Emit(true, uri)
+	svc.syncService = syncService
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- svc.Start(context.Background())
+	}()
+	require.Equal(t, "@every 30s", <-schedules)
+	require.Eventually(t, svc.IsReady, time.Second, 10*time.Millisecond)
+
+	// the reloaded provider polls on the new interval, and the previous one is stopped and closed
+	require.NoError(t, svc.Reload(context.Background(), Config{RedisURIs: []string{uri}, RedisInterval: 5}))
+	require.Equal(t, "@every 5s", <-schedules)
+	require.True(t, closed[0].Load())
+	require.False(t, closed[1].Load())
+	require.Eventually(t, svc.IsReady, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, svc.Shutdown())
+	require.True(t, closed[1].Load())
+	require.NoError(t, <-errs)
+}
+
+func TestService_ReloadKeepsProvidersOnError(t *testing.T) {
+	svc := newTestService(t, nil)
+	previous := &redis.Sync{URI: "redis"}
+	svc.redisSyncs = []*redis.Sync{previous}
+
+	require.Error(t, svc.Reload(context.Background(), Config{}))
+	require.Equal(t, []*redis.Sync{previous}, svc.providers())
+}
+
+func TestService_ReloadWhilePollInFlight(t *testing.T) {
+	const removedURI = "redis://localhost:6379/0?key=a"
+	const addedURI = "redis://localhost:6379/0?key=b"
+
+	svc := newTestService(t, nil)
+	svc.evaluator = evaluator.NewJSON(svc.logger, svc.flagStore)
+	svc.syncErrors = make(chan redis.SyncError, 1)
+
+	polls := make(chan func(), 2)
+	fetches := &atomic.Int32{}
+	var closed []*atomic.Bool
+	svc.buildProvider = func(uri string, cfg Config) (*redis.Sync, error) {
+		redisSync, err := NewProvider(uri, cfg)
+		if err != nil {
+			return nil, err
+		}
+		require.NoError(t, redisSync.Client.Close())
+
+		closed = append(closed, &atomic.Bool{})
+		client := fakeRedisClient{document: flagConfig("b"), closed: closed[len(closed)-1]}
+		if uri == removedURI {
+			redisSync.Client = changingRedisClient{fakeRedisClient: client, fetches: fetches}
+		} else {
+			redisSync.Client = client
+		}
+		redisSync.Cron = pollingCron{polls: polls}
+		return redisSync, nil
+	}
+	require.NoError(t, svc.Reload(context.Background(), Config{RedisURIs: []string{removedURI}, RedisInterval: 30}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = svc.runProviders(ctx)
+	}()
+
+	// the initial fetch waits in the data channel, nothing processing it yet, and a poll fetching a changed
+	// configuration is in flight
+	poll := <-polls
+	require.Eventually(t, func() bool { return len(svc.dataSync) == 1 }, time.Second, 10*time.Millisecond)
+	go poll()
+	require.Eventually(t, func() bool { return fetches.Load() == 2 }, time.Second, 10*time.Millisecond)
+
+	reloaded := make(chan error, 1)
+	go func() {
+		reloaded <- svc.Reload(ctx, Config{RedisURIs: []string{addedURI}, RedisInterval: 30})
+	}()
+
+	// the data queued by the removed source is only processed once its provider stopped
+	require.Eventually(t, closed[0].Load, time.Second, 10*time.Millisecond)
+	go func() {
+		_ = svc.processSyncData(ctx, svc.dataSync)
+	}()
+	require.NoError(t, <-reloaded)
+
+	// the flags of the removed source are gone for good, those of the added one served
+	require.Eventually(t, func() bool {
+		configuration, err := svc.GetFlagConfiguration()
+		require.NoError(t, err)
+		return strings.Contains(configuration, `"b"`)
+	}, time.Second, 10*time.Millisecond)
+	configuration, err := svc.GetFlagConfiguration()
+	require.NoError(t, err)
+	require.NotContains(t, configuration, `"a1"`)
+	require.NotContains(t, configuration, `"a2"`)
+}
@@ -2,8 +2,12 @@ package redissync
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"slices"
 	"sync"
 	"time"
 
@@ -14,93 +18,266 @@ import (
 	coresync "github.com/open-feature/flagd/core/pkg/sync"
 	"github.com/open-feature/flagd/core/pkg/sync/redis"
 	flagsync "github.com/open-feature/flagd/flagd/pkg/service/flag-sync"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
 
+// defaultShutdownTimeout bounds Shutdown when no timeout is configured
+const defaultShutdownTimeout = 10 * time.Second
+
 // Service represents a standalone Redis sync service that exposes flags via gRPC
 type Service struct {
-	redisSync   *redis.Sync
+	redisSyncs  []*redis.Sync
 	flagStore   *store.Store
 	syncService *flagsync.Service
 	evaluator   evaluator.IEvaluator
 	logger      *logger.Logger
-	mu          sync.RWMutex
+	batchWindow time.Duration
+	metricsPort uint16
+	healthPort  uint16
+	// buildProvider creates the Redis sync providers of Reload, NewProvider when nil
+	buildProvider func(uri string, cfg Config) (*redis.Sync, error)
+	// stopProviders stops the running providers and waits for them, set while they run
+	stopProviders func()
+	// strictSchema holds the redacted sources whose configurations are rejected unless they conform to the flagd flag
+	// schema
+	strictSchema map[string]bool
+	// minFlags holds the fewest flags the configuration of each redacted source may define, zero when unguarded
+	minFlags map[string]int
+	// allowWrite lets PublishConfig write to Redis
+	allowWrite bool
+	mu         sync.RWMutex
+
+	// shutdownTimeout bounds how long Shutdown waits for the goroutines of Start to finish
+	shutdownTimeout time.Duration
+	// cancel stops the goroutines of Start, done is closed once they finished
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	// dataSync carries the flag data of both the Redis sync providers and resyncs to the store
+	dataSync chan coresync.DataSync
+	// drainRequests asks processSyncData to apply the flag data already queued, closing the received channel once
+	// done
+	drainRequests chan chan struct{}
+	// syncErrors carries the failed fetches of the Redis sync providers
+	syncErrors chan redis.SyncError
+
+	validationErrors []ValidationError
+	lastRejected     time.Time
+	// lastFailures holds the time of the last failed fetch of each redacted source
+	lastFailures map[string]time.Time
+	// lastSync is the time the store was last updated from Redis
+	lastSync time.Time
+	// recentChanges keeps the latest flag changes applied to the store, counted by flagChanges
+	recentChanges changeRing
+	flagChanges   *prometheus.CounterVec
+	// registry holds the metrics of the service, served along those of the providers
+	registry *prometheus.Registry
+	// baseConfig is the configuration of the base flag file, the flags from Redis being overlaid on it
+	baseConfig string
+	// configSizes holds the size of the last configuration applied from each redacted source, reported by
+	// configBytes and configFlags
+	configSizes map[string]configSize
+	configBytes *prometheus.GaugeVec
+	configFlags *prometheus.GaugeVec
 }
 
 // Config holds configuration for the Redis sync service
 type Config struct {
-	RedisURI     string
+	// RedisURI is a shorthand for a single source, put ahead of RedisURIs when both are set
+	RedisURI string
+	// RedisURIs are the sources of the flag configuration, later ones taking precedence for flags defined in several
+	RedisURIs     []string
 	RedisInterval uint32
-	SyncPort     uint16
-	CertPath     string
-	KeyPath      string
-	SocketPath   string
-	Logger       *logger.Logger
+	CronSpec      string // standard cron expression polling instead of RedisInterval, overrides the cron of the URIs
+	Database      *int   // overrides the database of the URI paths when set
+	KeyPrefix     string // overrides the prefix of the URIs when set, prepended to every key
+	Username      string // overrides the username of the URIs when set
+	Password      string // overrides the password of the URIs when set, PasswordFile taking precedence
+	PasswordFile  string // read at start, overrides the password and password_file of the URIs
+	CacheFile     string // overrides the cache_file of the URI, only allowed with a single URI
+	BaseFile      string // flag file loaded at start, the flags from Redis overriding the ones it defines
+	SyncHost      string // host or IP address the gRPC sync service binds to, empty binding all interfaces
+	SyncPort      uint16
+	CertPath      string
+	KeyPath       string
+	ClientCAPath  string // requires gRPC clients to present a certificate signed by this CA, needs CertPath and KeyPath
+	SocketPath    string
+	MaxMsgSize    int           // largest gRPC message sent or received in bytes, zero keeps the gRPC defaults
+	BatchWindow   time.Duration // zero applies every update as soon as it arrives
+	MetricsPort   uint16        // zero disables the metrics endpoint
+	HealthPort    uint16        // zero disables the health probes
+	// TLSConfig connects to Redis with TLS configured by it, overriding the scheme and TLS parameters of the URIs
+	TLSConfig *tls.Config
+	// Timeouts override the timeouts of the URIs, zero durations keeping them
+	Timeouts redis.Timeouts
+	// Retries overrides the connect_retries of the URIs when set
+	Retries *int
+	// ShutdownTimeout bounds how long Shutdown waits for the service to stop, zero applies defaultShutdownTimeout
+	ShutdownTimeout time.Duration
+	// AllowWrite lets PublishConfig write configurations back to Redis, the service only reading from it otherwise
+	AllowWrite bool
+	Logger     *logger.Logger
 }
 
 // NewService creates a new Redis sync service
 func NewService(cfg Config) (*Service, error) {
-	// Create Redis sync provider
-	redisSync, err := redis.NewRedisSync(cfg.RedisURI, cfg.Logger)
+	// Check the TLS certificate of the gRPC sync service before anything is created
+	if err := validateSyncTLS(cfg); err != nil {
+		return nil, err
+	}
+
+	// Load the base flag file the flags from Redis are overlaid on
+	var baseConfig string
+	if cfg.BaseFile != "" {
+		var err error
+		if baseConfig, err = loadBaseFile(cfg.BaseFile); err != nil {
+			return nil, err
+		}
+	}
+
+	// Create a Redis sync provider per URI, all reporting failed fetches on the same channel
+	syncErrors := make(chan redis.SyncError, 1)
+	providers, err := newProviders(cfg, NewProvider, syncErrors)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create Redis sync provider: %w", err)
+		return nil, err
 	}
-	redisSync.SetInterval(cfg.RedisInterval)
 
-	// Create store for flag data
+	// Create store for flag data, merging the sources in the order of their URIs
 	flagStore, err := store.NewStore(cfg.Logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create flag store: %w", err)
 	}
+	flagStore.FlagSources = providers.sources
 
 	// Create evaluator for parsing flag data
 	eval := evaluator.NewJSON(cfg.Logger, flagStore)
 
-	// Create gRPC sync service
+	// Create gRPC sync service, reporting the readiness of the service once it is created
+	var svc *Service
 	syncService, err := flagsync.NewSyncService(flagsync.SvcConfigurations{
-		Logger:     cfg.Logger,
-		Port:       cfg.SyncPort,
-		Sources:    []string{cfg.RedisURI}, // Track Redis as source
-		Store:      flagStore,
-		CertPath:   cfg.CertPath,
-		KeyPath:    cfg.KeyPath,
-		SocketPath: cfg.SocketPath,
+		Logger:       cfg.Logger,
+		Host:         cfg.SyncHost,
+		Port:         cfg.SyncPort,
+		Sources:      providers.sources, // Track the Redis URIs as sources, sources are exposed to clients
+		Store:        flagStore,
+		CertPath:     cfg.CertPath,
+		KeyPath:      cfg.KeyPath,
+		ClientCAPath: cfg.ClientCAPath,
+		SocketPath:   cfg.SocketPath,
+		MaxMsgSize:   cfg.MaxMsgSize,
+		UpstreamStatus: func() error {
+			return svc.servingError()
+		},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create sync service: %w", err)
 	}
 
-	return &Service{
-		redisSync:   redisSync,
-		flagStore:   flagStore,
-		syncService: syncService,
-		evaluator:   eval,
-		logger:      cfg.Logger,
-	}, nil
+	shutdownTimeout := cfg.ShutdownTimeout
+	if shutdownTimeout == 0 {
+		shutdownTimeout = defaultShutdownTimeout
+	}
+
+	flagChanges := newFlagChangesCounter()
+	configBytes, configFlags := newConfigSizeGauges()
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(flagChanges, configBytes, configFlags, newActiveStreamsGauge(syncService))
+
+	svc = &Service{
+		redisSyncs:    providers.syncs,
+		flagStore:     flagStore,
+		syncService:   syncService,
+		evaluator:     eval,
+		logger:        cfg.Logger,
+		batchWindow:   cfg.BatchWindow,
+		metricsPort:   cfg.MetricsPort,
+		healthPort:    cfg.HealthPort,
+		strictSchema:  providers.strictSchema,
+		minFlags:      providers.minFlags,
+		allowWrite:    cfg.AllowWrite,
+		dataSync:      make(chan coresync.DataSync, 1),
+		syncErrors:    syncErrors,
+		drainRequests: make(chan chan struct{}),
+		flagChanges:   flagChanges,
+		registry:      registry,
+		configBytes:   configBytes,
+		configFlags:   configFlags,
+		baseConfig:    baseConfig,
+
+		shutdownTimeout: shutdownTimeout,
+	}
+	return svc, nil
+}
+
+// NewProvider creates the Redis sync provider of uri as the service does, applying the overrides of cfg
+func NewProvider(uri string, cfg Config) (*redis.Sync, error) {
+	redisSync, err := redis.NewRedisSyncWithConfig(redis.Config{
+		URI:          uri,
+		Username:     cfg.Username,
+		Password:     cfg.Password,
+		PasswordFile: cfg.PasswordFile,
+		TLSConfig:    cfg.TLSConfig,
+		Timeouts:     cfg.Timeouts,
+		Retries:      cfg.Retries,
+	}, cfg.Logger)
+	if err != nil {
+		return nil, err
+	}
+	redisSync.SetInterval(cfg.RedisInterval)
+	if cfg.CronSpec != "" {
+		if err := redisSync.SetCronSpec(cfg.CronSpec); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.KeyPrefix != "" {
+		redisSync.SetKeyPrefix(cfg.KeyPrefix)
+	}
+	if cfg.Database != nil {
+		if err := redisSync.SetDatabase(*cfg.Database); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.CacheFile != "" {
+		redisSync.CacheFile = cfg.CacheFile
+	}
+	return redisSync, nil
 }
 
 // Start starts the Redis sync service
 func (s *Service) Start(ctx context.Context) error {
 	s.logger.Info("Starting Redis sync service...")
 
+	// Let Shutdown stop the service and wait for it
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan struct{})
+	defer close(done)
+
+	s.mu.Lock()
+	s.cancel = cancel
+	s.done = done
+	s.mu.Unlock()
+
 	// Create error group for managing goroutines
 	g, gCtx := errgroup.WithContext(ctx)
 
-	// Channel for sync data
-	dataSync := make(chan coresync.DataSync, 1)
+	// Serve the base flag file until Redis is reached
+	s.mu.RLock()
+	sources := slices.Clone(s.flagStore.FlagSources)
+	s.mu.RUnlock()
+	s.applyBaseConfig(sources)
 
-	// Initialize Redis sync provider
-	if err := s.redisSync.Init(gCtx); err != nil {
-		return fmt.Errorf("failed to initialize Redis sync provider: %w", err)
+	// Initialize Redis sync providers
+	if err := initProviders(gCtx, s.providers()); err != nil {
+		return err
 	}
 
-	// Start Redis sync provider
+	// Start Redis sync providers, all feeding the shared data channel, restarting them when reloaded
 	g.Go(func() error {
-		s.logger.Info("Starting Redis sync provider...")
-		if err := s.redisSync.Sync(gCtx, dataSync); err != nil {
-			return fmt.Errorf("Redis sync error: %w", err)
-		}
-		return nil
+		return s.runProviders(gCtx)
 	})
 
 	// Start gRPC sync service
@@ -114,9 +291,28 @@ func (s *Service) Start(ctx context.Context) error {
 
 	// Process sync data updates
 	g.Go(func() error {
-		return s.processSyncData(gCtx, dataSync)
+		return s.processSyncData(gCtx, s.dataSync)
+	})
+
+	// Track failed fetches
+	g.Go(func() error {
+		return s.processSyncErrors(gCtx, s.syncErrors)
 	})
 
+	// Start metrics server
+	if s.metricsPort != 0 {
+		g.Go(func() error {
+			return s.startMetricsServer(gCtx)
+		})
+	}
+
+	// Start health server
+	if s.healthPort != 0 {
+		g.Go(func() error {
+			return s.startHealthServer(gCtx)
+		})
+	}
+
 	s.logger.Info("Redis sync service started successfully")
 
 	// Wait for all goroutines to complete or context cancellation
@@ -127,21 +323,56 @@ func (s *Service) Start(ctx context.Context) error {
 	return nil
 }
 
+// startMetricsServer serves the Redis sync metrics at /metrics until ctx is cancelled, labelled by source
+func (s *Service) startMetricsServer(ctx context.Context) error {
+	s.logger.Info("metrics listening", zap.Uint16("port", s.metricsPort))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		// the providers are looked up on every scrape, as a reload replaces them
+		gatherers := prometheus.Gatherers{redis.CombinedMetrics(s.providers()...)}
+		if s.registry != nil {
+			gatherers = append(gatherers, s.registry)
+		}
+		promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{}).ServeHTTP(w, r)
+	})
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", s.metricsPort),
+		ReadHeaderTimeout: 3 * time.Second,
+		Handler:           mux,
+	}
+
+	go func() {
+		<-ctx.Done()
+		if err := server.Shutdown(context.Background()); err != nil {
+			s.logger.Error("error shutting down metrics server", zap.Error(err))
+		}
+	}()
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("error returned from metrics server: %w", err)
+	}
+	return nil
+}
+
 // processSyncData handles incoming sync data from Redis and updates the store
 func (s *Service) processSyncData(ctx context.Context, dataSync <-chan coresync.DataSync) error {
+	if s.batchWindow > 0 {
+		return s.processBatchedSyncData(ctx, dataSync)
+	}
+
 	for {
 		select {
 		case data := <-dataSync:
-			s.logger.Debug(fmt.Sprintf("Received flag data from Redis: %s", data.Source))
-			
-			if err := s.updateStoreFromSyncData(data); err != nil {
-				s.logger.Error(fmt.Sprintf("Failed to update store: %v", err))
-				continue
+			s.applySyncData(ctx, data)
+
+		case drained := <-s.drainRequests:
+			for len(dataSync) > 0 {
+				s.applySyncData(ctx, <-dataSync)
 			}
-			
-			// Emit changes to sync service subscribers
-			s.syncService.Emit(false, data.Source)
-			
+			close(drained)
+
 		case <-ctx.Done():
 			s.logger.Info("Stopping sync data processor...")
 			return nil
@@ -149,8 +380,97 @@ func (s *Service) processSyncData(ctx context.Context, dataSync <-chan coresync.
 	}
 }
 
-// updateStoreFromSyncData parses flag data and updates the store
-func (s *Service) updateStoreFromSyncData(data coresync.DataSync) error {
+// applySyncData updates the store with data and publishes it to the sync service subscribers
+func (s *Service) applySyncData(ctx context.Context, data coresync.DataSync) {
+	data.Source = redis.RedactURI(data.Source)
+	s.logger.Debug("Received flag data from Redis",
+		zap.String("source", data.Source), zap.Int("byteSize", len(data.FlagData)))
+
+	if err := s.updateStoreFromSyncData(ctx, data); err != nil {
+		s.logger.Error("Failed to update store", zap.String("source", data.Source), zap.Error(err))
+		return
+	}
+
+	// Emit changes to sync service subscribers
+	s.syncService.Emit(false, data.Source)
+}
+
+// processBatchedSyncData collects updates arriving within the batch window and applies them together,
+// keeping only the latest payload of each source
+func (s *Service) processBatchedSyncData(ctx context.Context, dataSync <-chan coresync.DataSync) error {
+	var pending []coresync.DataSync
+	var flush <-chan time.Time
+
+	for {
+		select {
+		case data := <-dataSync:
+			data.Source = redis.RedactURI(data.Source)
+			s.logger.Debug("Received flag data from Redis",
+				zap.String("source", data.Source), zap.Int("byteSize", len(data.FlagData)))
+
+			pending = addToBatch(pending, data)
+			if flush == nil {
+				flush = time.After(s.batchWindow)
+			}
+
+		case <-flush:
+			s.applyBatch(ctx, pending)
+			pending = nil
+			flush = nil
+
+		case drained := <-s.drainRequests:
+			// apply the queued updates along with the batch rather than waiting for the window
+			for len(dataSync) > 0 {
+				data := <-dataSync
+				data.Source = redis.RedactURI(data.Source)
+				pending = addToBatch(pending, data)
+			}
+			if len(pending) > 0 {
+				s.applyBatch(ctx, pending)
+			}
+			pending = nil
+			flush = nil
+			close(drained)
+
+		case <-ctx.Done():
+			s.logger.Info("Stopping sync data processor...")
+			return nil
+		}
+	}
+}
+
+// addToBatch appends data to the batch, replacing any earlier payload of the same source
+func addToBatch(batch []coresync.DataSync, data coresync.DataSync) []coresync.DataSync {
+	for i, existing := range batch {
+		if existing.Source == data.Source {
+			batch[i] = data
+			return batch
+		}
+	}
+	return append(batch, data)
+}
+
+// applyBatch updates the store with every payload of the batch and publishes the merged state once
+func (s *Service) applyBatch(ctx context.Context, batch []coresync.DataSync) {
+	s.logger.Debug("Applying batch of flag data updates", zap.Int("updates", len(batch)))
+
+	var applied []string
+	for _, data := range batch {
+		if err := s.updateStoreFromSyncData(ctx, data); err != nil {
+			s.logger.Error("Failed to update store", zap.String("source", data.Source), zap.Error(err))
+			continue
+		}
+		applied = append(applied, data.Source)
+	}
+
+	// Emit with isResync set only tracks the source, so subscribers are published to once for the whole batch
+	for i, source := range applied {
+		s.syncService.Emit(i < len(applied)-1, source)
+	}
+}
+
+// updateStoreFromSyncData parses flag data and updates the store, a resync it triggers ending with ctx
+func (s *Service) updateStoreFromSyncData(ctx context.Context, data coresync.DataSync) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -159,27 +479,72 @@ func (s *Service) updateStoreFromSyncData(data coresync.DataSync) error {
 		return nil
 	}
 
-	s.logger.Debug(fmt.Sprintf("Updating store with %d bytes of flag data", len(data.FlagData)))
+	start := time.Now()
+	s.logger.Debug("Updating store with flag data",
+		zap.String("source", data.Source), zap.Int("byteSize", len(data.FlagData)))
+
+	// Reject invalid configurations, keeping the current flags in the store
+	if validationErrors := validateFlagConfiguration(data.FlagData); len(validationErrors) > 0 {
+		s.recordValidationErrors(validationErrors)
+		return fmt.Errorf("flag configuration rejected: %w", errors.Join(asErrors(validationErrors)...))
+	}
+	if s.strictSchema[data.Source] {
+		if validationErrors := validateSchema(s.logger, data.FlagData); len(validationErrors) > 0 {
+			s.recordValidationErrors(validationErrors)
+			return fmt.Errorf("flag configuration rejected by the flag schema: %w",
+				errors.Join(asErrors(validationErrors)...))
+		}
+	}
+
+	// Reject configurations with fewer flags than the guard of their source, likely truncated
+	size := configSize{bytes: len(data.FlagData), flags: countFlags(data.FlagData)}
+	if minFlags := s.minFlags[data.Source]; size.flags < minFlags {
+		validationErrors := []ValidationError{{
+			Reason: fmt.Sprintf("configuration defines %d flags, fewer than the minimum of %d", size.flags, minFlags),
+		}}
+		s.recordValidationErrors(validationErrors)
+		return fmt.Errorf("flag configuration rejected: %w", errors.Join(asErrors(validationErrors)...))
+	}
+
+	// Overlay the configuration on the base flag file
+	data, err := s.overlayBase(data)
+	if err != nil {
+		return err
+	}
 
 	// Use the evaluator to parse and update the store
 	// The evaluator's SetState method handles JSON parsing and store updates
 	notifications, resyncRequired, err := s.evaluator.SetState(data)
 	if err != nil {
+		s.recordValidationErrors([]ValidationError{{Reason: err.Error()}})
 		return fmt.Errorf("failed to update evaluator state: %w", err)
 	}
-
-	s.logger.Debug(fmt.Sprintf("Store updated successfully, %d flags changed, resync required: %v", 
-		len(notifications), resyncRequired))
-
-	// If resync is required, trigger a full resync
+	s.recordValidationErrors(nil)
+	s.recordFlagChanges(notifications)
+	s.recordConfigSize(data.Source, size)
+	s.lastSync = time.Now()
+
+	s.logger.Debug("Store updated successfully",
+		zap.String("source", data.Source),
+		zap.Int("byteSize", size.bytes),
+		zap.Int("flagsChanged", len(notifications)),
+		zap.Bool("resyncRequired", resyncRequired),
+		zap.Duration("duration", time.Since(start)),
+	)
+
+	// If resync is required, trigger a full resync of every source feeding back into the processed data, emitting
+	// even the configurations that are unchanged since the store dropped them
 	if resyncRequired {
 		s.logger.Info("Resync required, triggering full resync...")
+		redisSyncs := s.redisSyncs
 		go func() {
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 			defer cancel()
-			
-			if err := s.redisSync.ReSync(ctx, make(chan coresync.DataSync, 1)); err != nil {
-				s.logger.Error(fmt.Sprintf("Resync failed: %v", err))
+
+			for _, redisSync := range redisSyncs {
+				if err := redisSync.ForceReSync(ctx, s.dataSync); err != nil {
+					s.logger.Error("Resync failed", zap.String("source", redisSync.SourceName()), zap.Error(err))
+				}
 			}
 		}()
 	}
@@ -187,6 +552,38 @@ func (s *Service) updateStoreFromSyncData(data coresync.DataSync) error {
 	return nil
 }
 
+// syncMetadata describes the synchronization of the flag configuration
+type syncMetadata struct {
+	// LastSync is the time the configuration was last loaded from Redis, zero before the first load
+	LastSync time.Time `json:"lastSync"`
+}
+
+// lastSyncTime returns the time of the last store update or successful fetch from Redis, whichever is later, so
+// that it advances with every poll even when the configuration is unchanged. With several sources, the fetch is the
+// oldest of their last successful ones. The caller holds s.mu.
+func (s *Service) lastSyncTime() time.Time {
+	last := s.lastSync
+	if fetched := s.oldestFetch(); fetched.After(last) {
+		last = fetched
+	}
+	return last
+}
+
+// oldestFetch returns the oldest of the last successful fetches of the sources, zero if any never fetched
+func (s *Service) oldestFetch() time.Time {
+	var oldest time.Time
+	for i, redisSync := range s.redisSyncs {
+		fetched := redisSync.Stats().LastSyncTime
+		if fetched.IsZero() {
+			return time.Time{}
+		}
+		if i == 0 || fetched.Before(oldest) {
+			oldest = fetched
+		}
+	}
+	return oldest
+}
+
 // GetFlagConfiguration returns the current flag configuration as JSON
 func (s *Service) GetFlagConfiguration() (string, error) {
 	s.mu.RLock()
@@ -198,13 +595,15 @@ func (s *Service) GetFlagConfiguration() (string, error) {
 		return "", fmt.Errorf("failed to get flags from store: %w", err)
 	}
 
-	// Create flag configuration structure
+	// Create flag configuration structure, $metadata.lastSync telling how fresh it is even when unchanged
 	config := struct {
-		Flags    map[string]model.Flag `json:"flags"`
-		Metadata model.Metadata        `json:"$evaluators,omitempty"`
+		Flags        map[string]model.Flag `json:"flags"`
+		Metadata     model.Metadata        `json:"$evaluators,omitempty"`
+		SyncMetadata syncMetadata          `json:"$metadata"`
 	}{
-		Flags:    flags,
-		Metadata: metadata,
+		Flags:        flags,
+		Metadata:     metadata,
+		SyncMetadata: syncMetadata{LastSync: s.lastSyncTime()},
 	}
 
 	// Marshal to JSON
@@ -216,15 +615,39 @@ func (s *Service) GetFlagConfiguration() (string, error) {
 	return string(jsonData), nil
 }
 
-// IsReady returns true if the service is ready to serve requests
+// IsReady returns true if the service is ready to serve requests, the store having been populated and the last fetch
+// from each source having succeeded
 func (s *Service) IsReady() bool {
-	return s.redisSync.IsReady()
+	return s.isServing()
 }
 
-// Shutdown gracefully shuts down the service
-func (s *Service) Shutdown() {
+// Shutdown gracefully shuts down the service, stopping Start and waiting up to the shutdown timeout for it to
+// finish before closing the Redis connections
+func (s *Service) Shutdown() error {
 	s.logger.Info("Shutting down Redis sync service...")
-	
-	// The sync service and Redis sync provider will be stopped
-	// when the context is cancelled in the Start method
+
+	s.mu.RLock()
+	cancel, done := s.cancel, s.done
+	s.mu.RUnlock()
+
+	var err error
+	if cancel != nil {
+		cancel()
+
+		timer := time.NewTimer(s.shutdownTimeout)
+		select {
+		case <-done:
+			timer.Stop()
+		case <-timer.C:
+			err = fmt.Errorf("Redis sync service did not stop within %s", s.shutdownTimeout)
+		}
+	}
+
+	for _, redisSync := range s.providers() {
+		if closeErr := redisSync.Close(); closeErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to close the Redis connection of %s: %w",
+				redisSync.SourceName(), closeErr))
+		}
+	}
+	return err
 }
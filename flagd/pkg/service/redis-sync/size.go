@@ -0,0 +1,70 @@
+package redissync
+
+import (
+	"encoding/json"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// configSize describes the last configuration applied to the store from a source
+type configSize struct {
+	bytes int
+	flags int
+}
+
+// countFlags returns the number of flags defined by a configuration that passed validateFlagConfiguration
+func countFlags(data string) int {
+	var document struct {
+		Flags map[string]json.RawMessage `json:"flags"`
+	}
+	if err := json.Unmarshal([]byte(data), &document); err != nil {
+		return 0
+	}
+	return len(document.Flags)
+}
+
+// newConfigSizeGauges creates the gauges of the byte size and flag count of the last configuration applied from
+// each source
+func newConfigSizeGauges() (bytes *prometheus.GaugeVec, flags *prometheus.GaugeVec) {
+	bytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "flagd",
+		Subsystem: "redis_sync",
+		Name:      "config_bytes",
+		Help:      "Size in bytes of the last configuration applied to the store from the source",
+	}, []string{"source"})
+	flags = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "flagd",
+		Subsystem: "redis_sync",
+		Name:      "config_flags",
+		Help:      "Number of flags of the last configuration applied to the store from the source",
+	}, []string{"source"})
+	return bytes, flags
+}
+
+// recordConfigSize keeps the size of the configuration applied from source and sets the gauges. The caller holds
+// s.mu.
+func (s *Service) recordConfigSize(source string, size configSize) {
+	if s.configSizes == nil {
+		s.configSizes = map[string]configSize{}
+	}
+	s.configSizes[source] = size
+
+	if s.configBytes != nil {
+		s.configBytes.WithLabelValues(source).Set(float64(size.bytes))
+	}
+	if s.configFlags != nil {
+		s.configFlags.WithLabelValues(source).Set(float64(size.flags))
+	}
+}
+
+// forgetConfigSize drops the size of the configuration of a source no longer configured. The caller holds s.mu.
+func (s *Service) forgetConfigSize(source string) {
+	delete(s.configSizes, source)
+
+	if s.configBytes != nil {
+		s.configBytes.DeleteLabelValues(source)
+	}
+	if s.configFlags != nil {
+		s.configFlags.DeleteLabelValues(source)
+	}
+}
@@ -0,0 +1,57 @@
+package redissync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/evaluator"
+	coresync "github.com/open-feature/flagd/core/pkg/sync"
+	"github.com/open-feature/flagd/core/pkg/sync/redis"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_MinFlagsGuard(t *testing.T) {
+	svc := newTestService(t, nil)
+	svc.evaluator = evaluator.NewJSON(svc.logger, svc.flagStore)
+	// the provider serves the resyncs triggered by flags being deleted
+	svc.redisSyncs = []*redis.Sync{{
+		URI:    "redis",
+		Client: fakeRedisClient{document: flagConfig("a", "b")},
+		Logger: svc.logger,
+		Key:    "flags",
+	}}
+	svc.flagStore.FlagSources = []string{"redis"}
+	svc.minFlags = map[string]int{"redis": 2}
+	svc.configBytes, svc.configFlags = newConfigSizeGauges()
+
+	full := flagConfig("a", "b", "c")
+	require.NoError(t, svc.updateStoreFromSyncData(context.Background(),
+		coresync.DataSync{FlagData: full, Source: "redis"}))
+
+	source := svc.Status().Sources[0]
+	require.Equal(t, len(full), source.ConfigBytes)
+	require.Equal(t, 3, source.FlagCount)
+	require.InDelta(t, len(full), testutil.ToFloat64(svc.configBytes.WithLabelValues("redis")), 0)
+	require.InDelta(t, 3, testutil.ToFloat64(svc.configFlags.WithLabelValues("redis")), 0)
+
+	// a configuration at the minimum passes the guard
+	atMinimum := flagConfig("a", "b")
+	require.NoError(t, svc.updateStoreFromSyncData(context.Background(),
+		coresync.DataSync{FlagData: atMinimum, Source: "redis"}))
+	require.Equal(t, 2, svc.Status().Sources[0].FlagCount)
+
+	// a truncated configuration trips the guard, keeping the previous one
+	err := svc.updateStoreFromSyncData(context.Background(),
+		coresync.DataSync{FlagData: flagConfig("a"), Source: "redis"})
+	require.ErrorContains(t, err, "fewer than the minimum of 2")
+
+	status := svc.Status()
+	require.Len(t, status.ValidationErrors, 1)
+	require.False(t, status.LastRejected.IsZero())
+	require.Equal(t, len(atMinimum), status.Sources[0].ConfigBytes)
+	require.Equal(t, 2, status.Sources[0].FlagCount)
+
+	_, _, ok := svc.flagStore.Get(context.Background(), "b")
+	require.True(t, ok)
+}
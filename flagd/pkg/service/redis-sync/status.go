@@ -0,0 +1,143 @@
+package redissync
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/open-feature/flagd/core/pkg/sync/redis"
+	"go.uber.org/zap"
+)
+
+// Status reports the state of the Redis sync service
+type Status struct {
+	// ValidationErrors explains why the latest configuration was rejected, it is empty once one is accepted
+	ValidationErrors []ValidationError `json:"validationErrors,omitempty"`
+	LastRejected     time.Time         `json:"lastRejected"`
+	// LastFailure is the time of the last failed fetch from Redis
+	LastFailure time.Time `json:"lastFailure"`
+	// LastSyncTime is the time of the last successful fetch from Redis, the oldest one with several sources
+	LastSyncTime time.Time `json:"lastSyncTime"`
+	// LastError is the error of the last fetch from Redis, empty when it succeeded. With several sources, it joins
+	// the errors of those whose last fetch failed.
+	LastError  string `json:"lastError,omitempty"`
+	FetchCount uint64 `json:"fetchCount"`
+	// LastSHA is the digest of the last fetched configuration, only set with a single source
+	LastSHA string `json:"lastSHA,omitempty"`
+	// Sources reports the state of each source, in the order of their URIs
+	Sources []SourceStatus `json:"sources,omitempty"`
+	// RecentChanges lists the latest flags added, updated or deleted in the store, oldest first
+	RecentChanges []FlagChange `json:"recentChanges,omitempty"`
+	// ActiveStreams is the number of flagd clients streaming flags from the gRPC sync service
+	ActiveStreams int `json:"activeStreams"`
+}
+
+// SourceStatus reports the state of a single Redis source
+type SourceStatus struct {
+	// Source is the redacted URI of the source
+	Source       string    `json:"source"`
+	LastFailure  time.Time `json:"lastFailure"`
+	LastSyncTime time.Time `json:"lastSyncTime"`
+	LastError    string    `json:"lastError,omitempty"`
+	FetchCount   uint64    `json:"fetchCount"`
+	LastSHA      string    `json:"lastSHA,omitempty"`
+	// ConfigBytes and FlagCount are the byte size and number of flags of the last configuration applied to the store
+	ConfigBytes int `json:"configBytes"`
+	FlagCount   int `json:"flagCount"`
+	// ServerVersion and Modules describe the Redis server found at startup, empty when it refused to report them
+	ServerVersion string   `json:"serverVersion,omitempty"`
+	Modules       []string `json:"modules,omitempty"`
+}
+
+// Status returns a snapshot of the service state
+func (s *Service) Status() Status {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	status := Status{
+		ValidationErrors: slices.Clone(s.validationErrors),
+		LastRejected:     s.lastRejected,
+		RecentChanges:    s.recentChanges.list(),
+	}
+	if s.syncService != nil {
+		status.ActiveStreams = s.syncService.ActiveStreams()
+	}
+	for _, lastFailure := range s.lastFailures {
+		if lastFailure.After(status.LastFailure) {
+			status.LastFailure = lastFailure
+		}
+	}
+
+	var lastErrors []string
+	for _, redisSync := range s.redisSyncs {
+		source := s.sourceStatus(redisSync)
+		status.Sources = append(status.Sources, source)
+
+		if status.LastSyncTime.IsZero() || source.LastSyncTime.Before(status.LastSyncTime) {
+			status.LastSyncTime = source.LastSyncTime
+		}
+		status.FetchCount += source.FetchCount
+		if source.LastError != "" {
+			lastErrors = append(lastErrors, fmt.Sprintf("%s: %s", source.Source, source.LastError))
+		}
+	}
+	if len(status.Sources) == 1 {
+		status.LastSHA = status.Sources[0].LastSHA
+		status.LastError = status.Sources[0].LastError
+	} else {
+		status.LastError = strings.Join(lastErrors, "; ")
+	}
+
+	return status
+}
+
+// sourceStatus returns the state of the source of redisSync. The caller holds s.mu.
+func (s *Service) sourceStatus(redisSync *redis.Sync) SourceStatus {
+	stats := redisSync.Stats()
+	source := SourceStatus{
+		Source:       redisSync.SourceName(),
+		LastSyncTime: stats.LastSyncTime,
+		FetchCount:   stats.FetchCount,
+		LastSHA:      stats.LastSHA,
+		// the modules are shared with the provider
+		ServerVersion: stats.Server.Version,
+		Modules:       slices.Clone(stats.Server.Modules),
+	}
+	source.LastFailure = s.lastFailures[source.Source]
+	source.ConfigBytes = s.configSizes[source.Source].bytes
+	source.FlagCount = s.configSizes[source.Source].flags
+	if stats.LastError != nil {
+		source.LastError = stats.LastError.Error()
+	}
+	return source
+}
+
+// processSyncErrors records the failed fetches reported by the Redis sync providers until ctx is cancelled
+func (s *Service) processSyncErrors(ctx context.Context, syncErrors <-chan redis.SyncError) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-syncErrors:
+			s.logger.Warn("Redis sync degraded, fetch failed",
+				zap.String("source", event.Source), zap.Time("time", event.Time), zap.Error(event.Err))
+
+			s.mu.Lock()
+			if s.lastFailures == nil {
+				s.lastFailures = map[string]time.Time{}
+			}
+			s.lastFailures[event.Source] = event.Time
+			s.mu.Unlock()
+		}
+	}
+}
+
+// recordValidationErrors stores the reasons a configuration was rejected. Callers must hold s.mu.
+func (s *Service) recordValidationErrors(validationErrors []ValidationError) {
+	s.validationErrors = validationErrors
+	if len(validationErrors) > 0 {
+		s.lastRejected = time.Now()
+	}
+}
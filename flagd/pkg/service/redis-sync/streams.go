@@ -0,0 +1,18 @@
+package redissync
+
+import (
+	flagsync "github.com/open-feature/flagd/flagd/pkg/service/flag-sync"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// newActiveStreamsGauge creates the gauge of the gRPC sync streams open on syncService, read at every scrape
+func newActiveStreamsGauge(syncService *flagsync.Service) prometheus.GaugeFunc {
+	return prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "flagd",
+		Subsystem: "redis_sync",
+		Name:      "grpc_active_streams",
+		Help:      "Number of flagd clients streaming flags from the gRPC sync service",
+	}, func() float64 {
+		return float64(syncService.ActiveStreams())
+	})
+}
@@ -0,0 +1,68 @@
+package redissync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"buf.build/gen/go/open-feature/flagd/grpc/go/flagd/sync/v1/syncv1grpc"
+	syncv1 "buf.build/gen/go/open-feature/flagd/protocolbuffers/go/flagd/sync/v1"
+	flagsync "github.com/open-feature/flagd/flagd/pkg/service/flag-sync"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestService_ReportsActiveStreams(t *testing.T) {
+	// unix socket paths are limited in length, which a test directory may exceed
+	dir, err := os.MkdirTemp("", "redis-sync")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	socketPath := filepath.Join(dir, "sync.sock")
+
+	svc := newTestService(t, nil)
+	svc.flagStore.FlagSources = []string{"redis"}
+	syncService, err := flagsync.NewSyncService(flagsync.SvcConfigurations{
+		Logger:     svc.logger,
+		Store:      svc.flagStore,
+		Sources:    svc.flagStore.FlagSources,
+		SocketPath: socketPath,
+	})
+	require.NoError(t, err)
+	svc.syncService = syncService
+	gauge := newActiveStreamsGauge(syncService)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = syncService.Start(ctx)
+	}()
+	syncService.Emit(false, "redis")
+
+	conn, err := grpc.NewClient("unix://"+socketPath, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.Zero(t, svc.Status().ActiveStreams)
+	require.InDelta(t, 0, testutil.ToFloat64(gauge), 0)
+
+	// a client connects, its stream being open once the initial flags were received
+	streamCtx, closeStream := context.WithCancel(ctx)
+	stream, err := syncv1grpc.NewFlagSyncServiceClient(conn).SyncFlags(streamCtx, &syncv1.SyncFlagsRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	require.Equal(t, 1, svc.Status().ActiveStreams)
+	require.InDelta(t, 1, testutil.ToFloat64(gauge), 0)
+
+	// the client disconnects
+	closeStream()
+	require.Eventually(t, func() bool {
+		return svc.Status().ActiveStreams == 0
+	}, time.Second, 10*time.Millisecond)
+	require.InDelta(t, 0, testutil.ToFloat64(gauge), 0)
+}
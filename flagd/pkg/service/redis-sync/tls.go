@@ -0,0 +1,26 @@
+package redissync
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+)
+
+// validateSyncTLS checks that the TLS certificate and key of the gRPC sync service are set together and form a valid
+// pair, so that a misconfiguration fails at creation rather than when the gRPC service starts, or serves plaintext
+func validateSyncTLS(cfg Config) error {
+	switch {
+	case cfg.CertPath == "" && cfg.KeyPath == "":
+		return nil
+	case cfg.KeyPath == "":
+		return errors.New("the TLS certificate of the gRPC sync service requires a private key, set the key path")
+	case cfg.CertPath == "":
+		return errors.New("the TLS private key of the gRPC sync service requires a certificate, set the cert path")
+	}
+
+	if _, err := tls.LoadX509KeyPair(cfg.CertPath, cfg.KeyPath); err != nil {
+		return fmt.Errorf("invalid TLS certificate %s and key %s of the gRPC sync service: %w",
+			cfg.CertPath, cfg.KeyPath, err)
+	}
+	return nil
+}
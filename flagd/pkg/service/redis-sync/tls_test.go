@@ -0,0 +1,70 @@
+package redissync
+
+import (
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const testCertDir = "../flag-sync/test-cert/"
+
+func TestNewService_RejectsInvalidTLSPair(t *testing.T) {
+	tests := []struct {
+		name          string
+		certPath      string
+		keyPath       string
+		expectedError string
+	}{
+		{
+			name:          "certificate without key",
+			certPath:      testCertDir + "server-cert.pem",
+			expectedError: "requires a private key",
+		},
+		{
+			name:          "key without certificate",
+			keyPath:       testCertDir + "server-key.pem",
+			expectedError: "requires a certificate",
+		},
+		{
+			name:          "missing certificate",
+			certPath:      testCertDir + "missing-cert.pem",
+			keyPath:       testCertDir + "server-key.pem",
+			expectedError: "no such file or directory",
+		},
+		{
+			name:          "missing key",
+			certPath:      testCertDir + "server-cert.pem",
+			keyPath:       testCertDir + "missing-key.pem",
+			expectedError: "no such file or directory",
+		},
+		{
+			name:          "mismatched pair",
+			certPath:      testCertDir + "server-cert.pem",
+			keyPath:       testCertDir + "client-key.pem",
+			expectedError: "private key does not match public key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewService(Config{
+				RedisURIs: []string{"redis://localhost:6379/0?key=flags"},
+				CertPath:  tt.certPath,
+				KeyPath:   tt.keyPath,
+				Logger:    logger.NewLogger(zap.NewNop(), false),
+			})
+			require.ErrorContains(t, err, "gRPC sync service")
+			require.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestValidateSyncTLS(t *testing.T) {
+	require.NoError(t, validateSyncTLS(Config{}), "TLS is optional")
+	require.NoError(t, validateSyncTLS(Config{
+		CertPath: testCertDir + "server-cert.pem",
+		KeyPath:  testCertDir + "server-key.pem",
+	}))
+}
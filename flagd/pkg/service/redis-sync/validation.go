@@ -0,0 +1,112 @@
+package redissync
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/open-feature/flagd/core/pkg/evaluator"
+	"github.com/open-feature/flagd/core/pkg/logger"
+	"github.com/open-feature/flagd/core/pkg/model"
+)
+
+// ValidationError describes why a flag configuration was rejected. FlagKey is empty for document level errors.
+type ValidationError struct {
+	FlagKey string `json:"flagKey,omitempty"`
+	Reason  string `json:"reason"`
+}
+
+func (e ValidationError) Error() string {
+	if e.FlagKey == "" {
+		return e.Reason
+	}
+	return fmt.Sprintf("flag '%s': %s", e.FlagKey, e.Reason)
+}
+
+// validateFlagConfiguration checks the structure of a flag configuration document, returning every problem found
+func validateFlagConfiguration(data string) []ValidationError {
+	var document struct {
+		Flags map[string]json.RawMessage `json:"flags"`
+	}
+	if err := json.Unmarshal([]byte(data), &document); err != nil {
+		return []ValidationError{{Reason: fmt.Sprintf("invalid JSON document: %v", err)}}
+	}
+	if document.Flags == nil {
+		return []ValidationError{{Reason: "document has no 'flags' object"}}
+	}
+
+	keys := make([]string, 0, len(document.Flags))
+	for key := range document.Flags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var validationErrors []ValidationError
+	for _, key := range keys {
+		for _, reason := range validateFlag(document.Flags[key]) {
+			validationErrors = append(validationErrors, ValidationError{FlagKey: key, Reason: reason})
+		}
+	}
+
+	return validationErrors
+}
+
+// validateFlag returns the reasons a single flag definition is invalid
+func validateFlag(raw json.RawMessage) []string {
+	var flag model.Flag
+	if err := json.Unmarshal(raw, &flag); err != nil {
+		return []string{fmt.Sprintf("invalid flag definition: %v", err)}
+	}
+
+	var reasons []string
+	if flag.State != "ENABLED" && flag.State != "DISABLED" {
+		reasons = append(reasons, fmt.Sprintf("state '%s' must be ENABLED or DISABLED", flag.State))
+	}
+	if len(flag.Variants) == 0 {
+		reasons = append(reasons, "no variants defined")
+	}
+	if flag.DefaultVariant != "" {
+		if _, ok := flag.Variants[flag.DefaultVariant]; !ok {
+			reasons = append(reasons, fmt.Sprintf("defaultVariant '%s' isn't a valid variant", flag.DefaultVariant))
+		}
+	}
+
+	return reasons
+}
+
+// validateSchema checks a flag configuration against the flagd flag schema, returning every violation found
+func validateSchema(log *logger.Logger, data string) []ValidationError {
+	violations, err := evaluator.ValidateSchema(log, data)
+	if err != nil {
+		return []ValidationError{{Reason: err.Error()}}
+	}
+
+	validationErrors := make([]ValidationError, 0, len(violations))
+	for _, violation := range violations {
+		validationErrors = append(validationErrors, schemaValidationError(violation))
+	}
+	return validationErrors
+}
+
+// schemaValidationError attributes a schema violation to its flag when it lies within one
+func schemaValidationError(violation evaluator.SchemaViolation) ValidationError {
+	parts := strings.SplitN(violation.Field, ".", 3)
+	switch {
+	case len(parts) == 3 && parts[0] == "flags":
+		return ValidationError{FlagKey: parts[1], Reason: fmt.Sprintf("%s: %s", parts[2], violation.Description)}
+	case len(parts) == 2 && parts[0] == "flags":
+		return ValidationError{FlagKey: parts[1], Reason: violation.Description}
+	default:
+		return ValidationError{Reason: fmt.Sprintf("%s: %s", violation.Field, violation.Description)}
+	}
+}
+
+// asErrors converts validation errors for use with errors.Join
+func asErrors(validationErrors []ValidationError) []error {
+	errs := make([]error, len(validationErrors))
+	for i, validationError := range validationErrors {
+		errs[i] = validationError
+	}
+	return errs
+}
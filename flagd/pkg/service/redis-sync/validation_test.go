@@ -0,0 +1,78 @@
+package redissync
+
+import (
+	"testing"
+
+	"github.com/open-feature/flagd/core/pkg/evaluator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateFlagConfiguration(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected []ValidationError
+	}{
+		{
+			name: "valid configuration",
+			data: `{"flags":{"myFlag":{"state":"ENABLED","variants":{"on":true,"off":false},"defaultVariant":"on"}}}`,
+		},
+		{
+			name:     "invalid JSON",
+			data:     `{"flags":`,
+			expected: []ValidationError{{Reason: "invalid JSON document: unexpected end of JSON input"}},
+		},
+		{
+			name:     "missing flags object",
+			data:     `{"$evaluators":{}}`,
+			expected: []ValidationError{{Reason: "document has no 'flags' object"}},
+		},
+		{
+			name: "unknown default variant",
+			data: `{"flags":{"myFlag":{"state":"ENABLED","variants":{"on":true},"defaultVariant":"off"}}}`,
+			expected: []ValidationError{
+				{FlagKey: "myFlag", Reason: "defaultVariant 'off' isn't a valid variant"},
+			},
+		},
+		{
+			name: "several invalid flags",
+			data: `{"flags":{"b":{"state":"ON","variants":{"on":true}},"a":{"state":"DISABLED"}}}`,
+			expected: []ValidationError{
+				{FlagKey: "a", Reason: "no variants defined"},
+				{FlagKey: "b", Reason: "state 'ON' must be ENABLED or DISABLED"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, validateFlagConfiguration(tt.data))
+		})
+	}
+}
+
+func TestSchemaValidationError(t *testing.T) {
+	tests := []struct {
+		violation evaluator.SchemaViolation
+		expected  ValidationError
+	}{
+		{
+			violation: evaluator.SchemaViolation{Field: "flags.myFlag.variants", Description: "Must validate one schema"},
+			expected:  ValidationError{FlagKey: "myFlag", Reason: "variants: Must validate one schema"},
+		},
+		{
+			violation: evaluator.SchemaViolation{Field: "flags.myFlag", Description: "state is required"},
+			expected:  ValidationError{FlagKey: "myFlag", Reason: "state is required"},
+		},
+		{
+			violation: evaluator.SchemaViolation{Field: "(root)", Description: "flags is required"},
+			expected:  ValidationError{Reason: "(root): flags is required"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.violation.Field, func(t *testing.T) {
+			assert.Equal(t, tt.expected, schemaValidationError(tt.violation))
+		})
+	}
+}